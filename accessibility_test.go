@@ -0,0 +1,315 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"golang.org/x/net/html"
+)
+
+// accessibilityDashboard returns a dashboard using all the features
+// rendering interactive elements
+func accessibilityDashboard() types.DashboardInterface {
+	d := dashboard.New()
+	d.SetTitle("Reports")
+	d.SetSubtitle("Overview")
+	d.SetContent(`<p>Content</p>`)
+	d.SetLogoImageURL("/logo.png")
+	d.SetMenuMainItems([]types.MenuItem{
+		{Title: "Home", URL: "/", Icon: "bi bi-house"},
+		{Title: "Active Page", URL: "/active", IsActive: true},
+		{Title: "Reports", Children: []types.MenuItem{
+			{Title: "Sales", URL: "/reports/sales"},
+			{Title: "Forecast", URL: "/reports/forecast"},
+			{Title: "Archive", Children: []types.MenuItem{
+				{Title: "2024", URL: "/reports/archive/2024"},
+			}},
+		}},
+		{Title: "Settings", Children: []types.MenuItem{
+			{Title: "Profile", URL: "/settings/profile"},
+		}},
+	})
+	d.SetMenuUserItems([]types.MenuItem{{Title: "Logout", URL: "/logout"}})
+	d.SetMenuQuickAccessItems([]types.MenuItem{{Title: "New order", URL: "/orders/new"}})
+	d.SetThemeHandlerUrl("/theme")
+	d.SetSidebarHandlerUrl("/sidebar")
+	d.SetSearchHandlerUrl("/search")
+	d.SetCommandPaletteEnabled(true)
+	d.SetBreadcrumb([]types.BreadcrumbItem{{Title: "Home", URL: "/"}, {Title: "Reports"}})
+	d.SetActions([]types.Action{{Title: "Export", Icon: "download", OnClick: "exportReport()"}})
+	d.AddAlert(types.Alert{Type: "info", Message: "Saved"})
+	d.AddModal(types.Modal{ID: "ConfirmModal", Title: "Confirm", Content: "Sure?", CloseButton: true})
+	d.SetFooter(types.Footer{Copyright: "© Acme", Columns: []types.FooterColumn{
+		{Title: "Support", Links: []types.MenuItem{{Title: "Docs", URL: "/docs"}}},
+	}})
+	return d
+}
+
+func TestAccessibility(t *testing.T) {
+	tests := []struct {
+		name      string
+		loggedOut bool
+		setup     func(d types.DashboardInterface)
+	}{
+		{name: "bootstrap offcanvas", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+		}},
+		{name: "bootstrap modal", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+			d.SetMenuType(shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_MODAL)
+		}},
+		{name: "bootstrap sidebar", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+			d.SetMenuType(shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_SIDEBAR)
+		}},
+		{name: "bootstrap logged out", loggedOut: true, setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+			d.SetLoginURL("/login")
+			d.SetRegisterURL("/register")
+		}},
+		{name: "tabler vertical", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+		}},
+		{name: "tabler horizontal", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+			d.SetLayout(shared.TEMPLATE_TABLER_LAYOUT_HORIZONTAL)
+		}},
+		{name: "tabler combined", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+			d.SetLayout(shared.TEMPLATE_TABLER_LAYOUT_COMBINED)
+		}},
+		{name: "tabler logged out", loggedOut: true, setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+			d.SetLoginURL("/login")
+		}},
+		{name: "adminlte", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_ADMINLTE)
+		}},
+		{name: "adminlte top navigation", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_ADMINLTE)
+			d.SetAdminLTEConfig(types.AdminLTEConfig{LayoutTopNav: true})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := accessibilityDashboard()
+			if !tt.loggedOut {
+				d.SetUser(types.User{FirstName: "Jane", LastName: "Doe", AvatarURL: "/jane.png"})
+			}
+			tt.setup(d)
+
+			document, err := html.Parse(strings.NewReader(d.ToHTML()))
+			if err != nil {
+				t.Fatalf("failed to parse the page: %v", err)
+			}
+
+			for _, problem := range accessibilityProblems(document) {
+				t.Error(problem)
+			}
+		})
+	}
+}
+
+// accessibilityProblems returns the accessibility problems of the document
+func accessibilityProblems(document *html.Node) []string {
+	problems := []string{}
+	elements := accessibilityElements(document)
+
+	ids := map[string]*html.Node{}
+	labelled := map[string]bool{}
+	for _, element := range elements {
+		if id := accessibilityAttr(element, "id"); id != "" {
+			if _, exists := ids[id]; exists {
+				problems = append(problems, "duplicate id: "+id)
+			}
+			ids[id] = element
+		}
+		if element.Data == "label" {
+			labelled[accessibilityAttr(element, "for")] = true
+		}
+	}
+
+	mains := 0
+	var firstFocusable *html.Node
+
+	for _, element := range elements {
+		name := element.Data
+		describe := accessibilityDescribe(element)
+
+		if name == "html" && accessibilityAttr(element, "lang") == "" {
+			problems = append(problems, "the html element has no lang attribute")
+		}
+
+		for _, attr := range []string{"aria-labelledby", "aria-describedby", "aria-controls"} {
+			for _, id := range strings.Fields(accessibilityAttr(element, attr)) {
+				if _, exists := ids[id]; !exists {
+					problems = append(problems, attr+" references the missing id "+id+": "+describe)
+				}
+			}
+		}
+
+		switch name {
+		case "main":
+			mains++
+		case "nav", "aside":
+			if !accessibilityHasLabel(element) {
+				problems = append(problems, "unlabelled landmark: "+describe)
+			}
+		case "img":
+			if !accessibilityHasAttr(element, "alt") && accessibilityAttr(element, "aria-hidden") != "true" {
+				problems = append(problems, "image without alt text: "+describe)
+			}
+		case "button":
+			if accessibilityName(element) == "" {
+				problems = append(problems, "unlabelled button: "+describe)
+			}
+		case "a":
+			if accessibilityHasAttr(element, "href") && accessibilityName(element) == "" {
+				problems = append(problems, "unlabelled link: "+describe)
+			}
+		case "input", "select", "textarea":
+			if accessibilityAttr(element, "type") == "hidden" {
+				continue
+			}
+			if !accessibilityHasLabel(element) && accessibilityAttr(element, "title") == "" && !labelled[accessibilityAttr(element, "id")] {
+				problems = append(problems, "unlabelled form control: "+describe)
+			}
+		}
+
+		toggle := accessibilityAttr(element, "data-bs-toggle") + accessibilityAttr(element, "data-toggle")
+		if (toggle == "collapse" || toggle == "dropdown") && !accessibilityHasAttr(element, "aria-expanded") {
+			problems = append(problems, "toggle without aria-expanded: "+describe)
+		}
+
+		if name == "a" && accessibilityAttr(element, "href") == "/active" && accessibilityAttr(element, "aria-current") != "page" {
+			problems = append(problems, "active menu item without aria-current: "+describe)
+		}
+
+		if firstFocusable == nil && accessibilityIsFocusable(element) {
+			firstFocusable = element
+		}
+	}
+
+	if mains != 1 {
+		problems = append(problems, "expected one main landmark")
+	}
+
+	// The first focusable element skips to the main content
+	if firstFocusable == nil || firstFocusable.Data != "a" || !strings.HasPrefix(accessibilityAttr(firstFocusable, "href"), "#") {
+		problems = append(problems, "the page does not start with a skip link")
+	} else if target, exists := ids[strings.TrimPrefix(accessibilityAttr(firstFocusable, "href"), "#")]; !exists || !accessibilityIsWithin(target, "main") {
+		problems = append(problems, "the skip link does not target the main content")
+	}
+
+	return problems
+}
+
+// accessibilityElements returns the elements of the document in document order
+func accessibilityElements(node *html.Node) []*html.Node {
+	elements := []*html.Node{}
+	if node.Type == html.ElementNode {
+		elements = append(elements, node)
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		elements = append(elements, accessibilityElements(child)...)
+	}
+	return elements
+}
+
+func accessibilityAttr(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return strings.TrimSpace(attr.Val)
+		}
+	}
+	return ""
+}
+
+func accessibilityHasAttr(node *html.Node, key string) bool {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func accessibilityHasLabel(node *html.Node) bool {
+	return accessibilityAttr(node, "aria-label") != "" || accessibilityAttr(node, "aria-labelledby") != ""
+}
+
+// accessibilityName approximates the accessible name of the element from
+// its labels, its title, and the text and images of its visible content
+func accessibilityName(node *html.Node) string {
+	if accessibilityHasLabel(node) {
+		return "labelled"
+	}
+	if title := accessibilityAttr(node, "title"); title != "" {
+		return title
+	}
+
+	name := ""
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			name += n.Data
+			return
+		}
+		if n.Type == html.ElementNode {
+			if accessibilityAttr(n, "aria-hidden") == "true" {
+				return
+			}
+			if n.Data == "img" {
+				name += accessibilityAttr(n, "alt")
+			}
+			if n != node {
+				name += accessibilityAttr(n, "aria-label")
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(node)
+
+	return strings.TrimSpace(name)
+}
+
+func accessibilityIsFocusable(node *html.Node) bool {
+	if accessibilityAttr(node, "tabindex") == "-1" {
+		return false
+	}
+	switch node.Data {
+	case "a":
+		return accessibilityHasAttr(node, "href")
+	case "button", "select", "textarea":
+		return true
+	case "input":
+		return accessibilityAttr(node, "type") != "hidden"
+	}
+	return false
+}
+
+func accessibilityIsWithin(node *html.Node, tag string) bool {
+	for ; node != nil; node = node.Parent {
+		if node.Type == html.ElementNode && node.Data == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// accessibilityDescribe returns the opening tag of the element
+func accessibilityDescribe(node *html.Node) string {
+	var builder strings.Builder
+	builder.WriteString("<" + node.Data)
+	for _, attr := range node.Attr {
+		builder.WriteString(" " + attr.Key + `="` + attr.Val + `"`)
+	}
+	builder.WriteString(">")
+	return builder.String()
+}
@@ -0,0 +1,122 @@
+package dashboard
+
+import (
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// csrfFieldNameDefault is the name of the CSRF field of the auth forms
+const csrfFieldNameDefault = "csrf_token"
+
+// LoginForm returns the sign in form, posted to the login URL, with links
+// to the forgot password page and to the register URL when they are set.
+// The markup works with all the templates, e.g. as the content of a page
+// with the auth layout:
+//
+//	d.SetPageLayout(dashboard.PAGE_LAYOUT_AUTH)
+//	d.SetContentFragment(types.FragmentTag(dashboard.LoginForm(d, types.AuthForm{CSRFToken: token})))
+func LoginForm(d types.DashboardInterface, form types.AuthForm) *hb.Tag {
+	action := lo.Ternary(form.Action != "", form.Action, d.GetLoginURL())
+
+	options := hb.Div().Class("d-flex justify-content-between align-items-center mb-3")
+	if form.RememberMe {
+		options.Child(hb.Div().Class("form-check").
+			Child(hb.Input().Type("checkbox").Class("form-check-input").ID("AuthRememberMe").Name("remember").Value("1")).
+			Child(hb.Label().Class("form-check-label").For("AuthRememberMe").Text(d.Translate(shared.MESSAGE_REMEMBER_ME))))
+	}
+	if form.ForgotPasswordURL != "" {
+		options.Child(hb.Hyperlink().Class("ms-auto ml-auto").Href(form.ForgotPasswordURL).Text(d.Translate(shared.MESSAGE_FORGOT_PASSWORD)))
+	}
+
+	return authForm(action, form).
+		Child(authField(authInput("email", "AuthEmail", "email", "username").Value(form.Email), d.Translate(shared.MESSAGE_EMAIL))).
+		Child(authField(authInput("password", "AuthPassword", "password", "current-password"), d.Translate(shared.MESSAGE_PASSWORD))).
+		ChildIf(form.RememberMe || form.ForgotPasswordURL != "", options).
+		Child(authSubmit(d.Translate(shared.MESSAGE_SIGN_IN))).
+		ChildIf(d.GetRegisterURL() != "", authLink(d.Translate(shared.MESSAGE_NOT_REGISTERED), d.Translate(shared.MESSAGE_REGISTER), d.GetRegisterURL()))
+}
+
+// RegisterForm returns the registration form, posted to the register URL,
+// with a link to the login URL when it is set
+func RegisterForm(d types.DashboardInterface, form types.AuthForm) *hb.Tag {
+	action := lo.Ternary(form.Action != "", form.Action, d.GetRegisterURL())
+
+	return authForm(action, form).
+		Child(authField(authInput("text", "AuthName", "name", "name"), d.Translate(shared.MESSAGE_NAME))).
+		Child(authField(authInput("email", "AuthEmail", "email", "email").Value(form.Email), d.Translate(shared.MESSAGE_EMAIL))).
+		Child(authField(authInput("password", "AuthPassword", "password", "new-password"), d.Translate(shared.MESSAGE_PASSWORD))).
+		Child(authField(authInput("password", "AuthPasswordConfirmation", "password_confirmation", "new-password"), d.Translate(shared.MESSAGE_CONFIRM_PASSWORD))).
+		Child(authSubmit(d.Translate(shared.MESSAGE_REGISTER))).
+		ChildIf(d.GetLoginURL() != "", authLink(d.Translate(shared.MESSAGE_ALREADY_REGISTERED), d.Translate(shared.MESSAGE_SIGN_IN), d.GetLoginURL()))
+}
+
+// ForgotPasswordForm returns the form requesting a password reset link,
+// posted to the action or the current URL, with a link back to the login URL
+func ForgotPasswordForm(d types.DashboardInterface, form types.AuthForm) *hb.Tag {
+	return authForm(form.Action, form).
+		Child(hb.P().Class("text-muted").Text(d.Translate(shared.MESSAGE_FORGOT_PASSWORD_HELP))).
+		Child(authField(authInput("email", "AuthEmail", "email", "email").Value(form.Email), d.Translate(shared.MESSAGE_EMAIL))).
+		Child(authSubmit(d.Translate(shared.MESSAGE_SEND_RESET_LINK))).
+		ChildIf(d.GetLoginURL() != "", authLink("", d.Translate(shared.MESSAGE_BACK_TO_SIGN_IN), d.GetLoginURL()))
+}
+
+// TwoFactorForm returns the form asking for the code of the authenticator
+// app, posted to the action or the current URL, with a link back to the
+// login URL
+func TwoFactorForm(d types.DashboardInterface, form types.AuthForm) *hb.Tag {
+	code := authInput("text", "AuthCode", "code", "one-time-code").
+		Attr("inputmode", "numeric").
+		Attr("autofocus", "autofocus")
+
+	return authForm(form.Action, form).
+		Child(hb.P().Class("text-muted").Text(d.Translate(shared.MESSAGE_TWO_FACTOR_HELP))).
+		Child(authField(code, d.Translate(shared.MESSAGE_TWO_FACTOR_CODE))).
+		Child(authSubmit(d.Translate(shared.MESSAGE_VERIFY))).
+		ChildIf(d.GetLoginURL() != "", authLink("", d.Translate(shared.MESSAGE_BACK_TO_SIGN_IN), d.GetLoginURL()))
+}
+
+// authForm returns the form posted to the action, with the CSRF field
+func authForm(action string, form types.AuthForm) *hb.Tag {
+	fieldName := lo.Ternary(form.CSRFFieldName != "", form.CSRFFieldName, csrfFieldNameDefault)
+
+	formTag := hb.Form().Method("post")
+	if action != "" {
+		formTag.Action(action)
+	}
+
+	return formTag.
+		ChildIf(form.CSRFToken != "", hb.Input().Type("hidden").Name(fieldName).Value(form.CSRFToken))
+}
+
+// authInput returns a required input of the auth forms
+func authInput(inputType, id, name, autocomplete string) *hb.Tag {
+	return hb.Input().
+		Type(inputType).
+		Class("form-control").
+		ID(id).
+		Name(name).
+		Attr("autocomplete", autocomplete).
+		Required(true)
+}
+
+// authField returns the input with its label
+func authField(input *hb.Tag, label string) *hb.Tag {
+	return hb.Div().Class("mb-3").
+		Child(hb.Label().Class("form-label").For(input.GetAttribute("id")).Text(label)).
+		Child(input)
+}
+
+// authSubmit returns the full width submit button of the auth forms
+func authSubmit(label string) *hb.Tag {
+	return hb.Button().Type("submit").Class("btn btn-primary w-100").Text(label)
+}
+
+// authLink returns the centred link below the auth forms, e.g.
+// "Don't have an account yet? Register"
+func authLink(text, label, url string) *hb.Tag {
+	return hb.P().Class("text-center mt-3 mb-0").
+		TextIf(text != "", text+" ").
+		Child(hb.Hyperlink().Href(url).Text(label))
+}
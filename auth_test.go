@@ -0,0 +1,208 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	templatesshared "github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"golang.org/x/net/html"
+)
+
+func TestPageLayoutDefault(t *testing.T) {
+	d := dashboard.New()
+
+	if d.GetPageLayout() != shared.PAGE_LAYOUT_DASHBOARD {
+		t.Errorf("expected the dashboard page layout, got %q", d.GetPageLayout())
+	}
+}
+
+func TestAuthLayout(t *testing.T) {
+	tests := []struct {
+		template string
+		expected []string
+	}{
+		{template: shared.TEMPLATE_BOOTSTRAP, expected: []string{
+			`<main class="d-flex align-items-center justify-content-center min-vh-100 p-3 bg-body-tertiary" id="DashboardContent"`,
+			`<div class="card shadow-sm"><div class="card-body p-4"><h1 class="h4 text-center mb-4">Sign in</h1>`,
+		}},
+		{template: shared.TEMPLATE_TABLER, expected: []string{
+			`<body class="antialiased d-flex flex-column theme-`,
+			`<main class="page page-center" id="DashboardContent"`,
+			`<a class="navbar-brand navbar-brand-autodark" href="/home"><img alt="Home"`,
+			`<div class="card card-md"><div class="card-body"><h1 class="h4 text-center mb-4">Sign in</h1>`,
+		}},
+		{template: shared.TEMPLATE_ADMINLTE, expected: []string{
+			`<body class="hold-transition login-page">`,
+			`<main class="login-box" id="DashboardContent"`,
+			`<div class="login-logo"><a href="/home"><img alt="Home"`,
+			`<div class="card-body login-card-body"><h1 class="h4 text-center mb-4">Sign in</h1>`,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(tt.template)
+			d.SetPageLayout(shared.PAGE_LAYOUT_AUTH)
+			d.SetTitle("Sign in")
+			d.SetLogoImageURL("/logo.svg")
+			d.SetLogoRedirectURL("/home")
+			d.SetMenuMainItems([]types.MenuItem{{Title: "Main menu item", URL: "/main"}})
+			d.AddAlert(types.Alert{Type: "danger", Message: "Invalid <password>"})
+			d.SetFooter(types.Footer{
+				Copyright: "© Acme",
+				Columns:   []types.FooterColumn{{Links: []types.MenuItem{{Title: "Privacy", URL: "/privacy"}}}},
+			})
+			d.SetContent(`<p id="auth-content"></p>`)
+
+			html := d.ToHTML()
+
+			expected := append(tt.expected,
+				`<img alt="Home" src="/logo.svg"`,
+				`<div class="alert alert-danger" role="alert">Invalid &lt;password&gt;</div>`,
+				`<p id="auth-content"></p>`,
+				`href="/privacy">Privacy</a>`,
+				`© Acme`,
+			)
+			for _, s := range expected {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+
+			if strings.Contains(html, "Main menu item") {
+				t.Error("expected the auth layout without the navigation")
+			}
+
+			if strings.Contains(html, templatesshared.FRAGMENT_PLACEHOLDER_PREFIX) {
+				t.Error("expected all the placeholders to be replaced")
+			}
+		})
+	}
+}
+
+func TestAuthForms(t *testing.T) {
+	d := dashboard.New()
+	d.SetLoginURL("/auth/login")
+	d.SetRegisterURL("/auth/register")
+
+	tests := []struct {
+		name        string
+		html        string
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name: "login",
+			html: dashboard.LoginForm(d, types.AuthForm{
+				CSRFToken:         "token",
+				Email:             "jane@example.com",
+				ForgotPasswordURL: "/auth/forgot",
+				RememberMe:        true,
+			}).ToHTML(),
+			expected: []string{
+				`<form action="/auth/login" method="post">`,
+				`<input name="csrf_token" type="hidden" value="token" />`,
+				`<label class="form-label" for="AuthEmail">Email address</label>`,
+				`<input autocomplete="username" class="form-control" id="AuthEmail" name="email" required="required" type="email" value="jane@example.com" />`,
+				`autocomplete="current-password"`,
+				`name="remember"`,
+				`<a class="ms-auto ml-auto" href="/auth/forgot">Forgot password?</a>`,
+				`<button class="btn btn-primary w-100" type="submit">Sign in</button>`,
+				`Don&#39;t have an account yet? <a href="/auth/register">Register</a>`,
+			},
+		},
+		{
+			name:        "login without options",
+			html:        dashboard.LoginForm(d, types.AuthForm{Action: "/custom"}).ToHTML(),
+			expected:    []string{`<form action="/custom" method="post">`},
+			notExpected: []string{`csrf_token`, `name="remember"`, `Forgot password?`},
+		},
+		{
+			name: "register",
+			html: dashboard.RegisterForm(d, types.AuthForm{CSRFFieldName: "_token", CSRFToken: "token"}).ToHTML(),
+			expected: []string{
+				`<form action="/auth/register" method="post">`,
+				`<input name="_token" type="hidden" value="token" />`,
+				`name="name"`,
+				`name="password_confirmation"`,
+				`autocomplete="new-password"`,
+				`<button class="btn btn-primary w-100" type="submit">Register</button>`,
+				`Already have an account? <a href="/auth/login">Sign in</a>`,
+			},
+		},
+		{
+			name: "forgot password",
+			html: dashboard.ForgotPasswordForm(d, types.AuthForm{}).ToHTML(),
+			expected: []string{
+				`<form method="post">`,
+				`name="email"`,
+				`<button class="btn btn-primary w-100" type="submit">Send reset link</button>`,
+				`<a href="/auth/login">Back to sign in</a>`,
+			},
+			notExpected: []string{`name="password"`},
+		},
+		{
+			name: "two factor",
+			html: dashboard.TwoFactorForm(d, types.AuthForm{Action: "/auth/2fa"}).ToHTML(),
+			expected: []string{
+				`<form action="/auth/2fa" method="post">`,
+				`autocomplete="one-time-code"`,
+				`inputmode="numeric"`,
+				`<button class="btn btn-primary w-100" type="submit">Verify</button>`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, s := range tt.expected {
+				if !strings.Contains(tt.html, s) {
+					t.Errorf("expected HTML to contain %q, got %s", s, tt.html)
+				}
+			}
+			for _, s := range tt.notExpected {
+				if strings.Contains(tt.html, s) {
+					t.Errorf("expected HTML not to contain %q", s)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthLayoutAccessibility(t *testing.T) {
+	forms := map[string]func(d types.DashboardInterface, form types.AuthForm) *hb.Tag{
+		"login":           dashboard.LoginForm,
+		"register":        dashboard.RegisterForm,
+		"forgot password": dashboard.ForgotPasswordForm,
+		"two factor":      dashboard.TwoFactorForm,
+	}
+
+	for _, template := range []string{shared.TEMPLATE_BOOTSTRAP, shared.TEMPLATE_TABLER, shared.TEMPLATE_ADMINLTE} {
+		for name, form := range forms {
+			t.Run(template+" "+name, func(t *testing.T) {
+				d := dashboard.New()
+				d.SetTemplate(template)
+				d.SetPageLayout(shared.PAGE_LAYOUT_AUTH)
+				d.SetTitle("Welcome")
+				d.SetLogoImageURL("/logo.png")
+				d.SetLoginURL("/login")
+				d.SetRegisterURL("/register")
+				d.SetContentFragment(types.FragmentTag(form(d, types.AuthForm{RememberMe: true, ForgotPasswordURL: "/forgot"})))
+
+				document, err := html.Parse(strings.NewReader(d.ToHTML()))
+				if err != nil {
+					t.Fatalf("failed to parse the page: %v", err)
+				}
+
+				for _, problem := range accessibilityProblems(document) {
+					t.Error(problem)
+				}
+			})
+		}
+	}
+}
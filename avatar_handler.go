@@ -0,0 +1,41 @@
+package dashboard
+
+import (
+	"log"
+	"net/http"
+
+	templatesshared "github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/req"
+)
+
+// AvatarDataURI returns the generated avatar of the user, the initials of
+// the user on a color hashed from the ID or the email, as an SVG data URI.
+// The templates use it for the users without an avatar image.
+func AvatarDataURI(user types.User, size int, shape string) string {
+	return templatesshared.AvatarDataURI(user, size, shape)
+}
+
+// AvatarHandler serves the generated avatar as an SVG image, for the pages
+// and the emails which link to the avatar instead of embedding it. The
+// initials are taken from the "name" parameter, the color is hashed from
+// the "id" parameter (the name when empty), the "size" and the "shape"
+// parameters are optional, e.g. /avatar?name=Jane+Doe&id=42&size=128.
+func AvatarHandler(w http.ResponseWriter, r *http.Request) {
+	user := types.User{
+		ID:          req.GetStringTrimmed(r, "id"),
+		DisplayName: req.GetStringTrimmed(r, "name"),
+	}
+	size := req.GetIntOr(r, "size", templatesshared.AVATAR_SIZE_DEFAULT)
+	shape := req.GetStringTrimmed(r, "shape")
+
+	w.Header().Set("Content-Type", "image/svg+xml")
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write([]byte(templatesshared.AvatarSVG(user, size, shape))); err != nil {
+		log.Println(err.Error())
+	}
+}
@@ -0,0 +1,140 @@
+package dashboard_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// avatarSVG decodes the SVG of the avatar data URI
+func avatarSVG(t *testing.T, uri string) string {
+	t.Helper()
+
+	encoded, found := strings.CutPrefix(uri, "data:image/svg+xml;base64,")
+	if !found {
+		t.Fatalf("expected an SVG data URI, got %q", uri)
+	}
+
+	svg, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(svg)
+}
+
+func TestAvatarDataURI(t *testing.T) {
+	jane := types.User{ID: "42", FirstName: "Jane", LastName: "Doe"}
+
+	if dashboard.AvatarDataURI(jane, 64, "") != dashboard.AvatarDataURI(jane, 64, "") {
+		t.Error("expected the avatar to be deterministic")
+	}
+
+	if dashboard.AvatarDataURI(jane, 64, "") == dashboard.AvatarDataURI(types.User{ID: "43", FirstName: "Jane", LastName: "Doe"}, 64, "") {
+		t.Error("expected the color to depend on the ID")
+	}
+
+	tests := []struct {
+		name        string
+		user        types.User
+		size        int
+		shape       string
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name:     "circle",
+			user:     jane,
+			size:     64,
+			expected: []string{`width="64" height="64"`, `rx="32"`, `>JD</text>`},
+		},
+		{
+			name:     "rounded",
+			user:     jane,
+			size:     48,
+			shape:    shared.AVATAR_SHAPE_ROUNDED,
+			expected: []string{`width="48" height="48"`, `rx="8"`},
+		},
+		{
+			name:     "square",
+			user:     jane,
+			size:     48,
+			shape:    shared.AVATAR_SHAPE_SQUARE,
+			expected: []string{`rx="0"`},
+		},
+		{
+			name:     "default size",
+			user:     jane,
+			size:     0,
+			expected: []string{`width="64"`},
+		},
+		{
+			name:     "maximum size",
+			user:     jane,
+			size:     10000,
+			expected: []string{`width="512"`},
+		},
+		{
+			name:        "escaped initials",
+			user:        types.User{FirstName: "<script>", LastName: "&"},
+			size:        32,
+			notExpected: []string{"<script>"},
+		},
+		{
+			name:     "multi-byte initials",
+			user:     types.User{Email: "orjan@example.com", FirstName: "Ørjan", LastName: "Ødegård"},
+			size:     32,
+			expected: []string{`>ØØ</text>`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svg := avatarSVG(t, dashboard.AvatarDataURI(tt.user, tt.size, tt.shape))
+
+			if !strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg"`) {
+				t.Errorf("expected an SVG document, got %q", svg)
+			}
+			for _, s := range tt.expected {
+				if !strings.Contains(svg, s) {
+					t.Errorf("expected SVG to contain %q, got %q", s, svg)
+				}
+			}
+			for _, s := range tt.notExpected {
+				if strings.Contains(svg, s) {
+					t.Errorf("expected SVG not to contain %q", s)
+				}
+			}
+		})
+	}
+}
+
+func TestAvatarHandler(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/avatar?name=Jane+Doe&id=42&size=128&shape=square", nil)
+	recorder := httptest.NewRecorder()
+
+	dashboard.AvatarHandler(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, recorder.Code)
+	}
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "image/svg+xml" {
+		t.Errorf("expected the SVG content type, got %q", contentType)
+	}
+
+	if recorder.Header().Get("Cache-Control") == "" {
+		t.Error("expected the avatar to be cacheable")
+	}
+
+	user := types.User{ID: "42", DisplayName: "Jane Doe"}
+	if expected := avatarSVG(t, dashboard.AvatarDataURI(user, 128, shared.AVATAR_SHAPE_SQUARE)); recorder.Body.String() != expected {
+		t.Errorf("expected the handler to serve the same avatar as the data URI, got %q", recorder.Body.String())
+	}
+}
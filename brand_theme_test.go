@@ -0,0 +1,140 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/samber/lo"
+)
+
+func TestBrandThemeRegisteredInCatalog(t *testing.T) {
+	d := dashboard.New()
+	d.AddBrandTheme(types.BrandTheme{
+		ID:           "acme",
+		Name:         "Acme",
+		IsDark:       true,
+		PrimaryColor: "#ff6600",
+	})
+
+	theme, found := lo.Find(d.GetThemes(), func(theme types.Theme) bool { return theme.ID == "acme" })
+	if !found {
+		t.Fatal("expected brand theme to be in the theme catalog")
+	}
+	if theme.Name != "Acme" || !theme.IsDark {
+		t.Errorf("unexpected catalog entry %+v", theme)
+	}
+	if len(theme.PreviewColors) == 0 || theme.PreviewColors[0] != "#ff6600" {
+		t.Errorf("expected primary color to be the first preview color, got %v", theme.PreviewColors)
+	}
+
+	d.SetTheme("acme")
+	if !d.IsThemeDark() {
+		t.Error("expected dark brand theme to be reported as dark")
+	}
+
+	// Registering the same ID again replaces the brand theme
+	d.AddBrandTheme(types.BrandTheme{ID: "acme", Name: "Acme Light"})
+	if len(d.GetBrandThemes()) != 1 {
+		t.Fatalf("expected 1 brand theme, got %d", len(d.GetBrandThemes()))
+	}
+	if d.IsThemeDark() {
+		t.Error("expected replaced brand theme to be light")
+	}
+}
+
+func TestBrandThemeRendering(t *testing.T) {
+	brand := types.BrandTheme{
+		ID:           "acme",
+		Name:         "Acme",
+		PrimaryColor: "#ff6600",
+		DangerColor:  "#cc0000",
+		FontFamily:   "Inter, sans-serif",
+		BorderRadius: "0.75rem",
+		SpacingScale: 1.5,
+	}
+
+	tests := []struct {
+		template string
+		expected []string
+	}{
+		{
+			template: shared.TEMPLATE_BOOTSTRAP,
+			expected: []string{"--bs-primary: #ff6600;", "--bs-primary-rgb: 255, 102, 0;", "--bs-danger: #cc0000;", "--bs-body-font-family: Inter, sans-serif;", "--bs-border-radius: 0.75rem;", "--bs-gutter-x: 2.25rem;"},
+		},
+		{
+			template: shared.TEMPLATE_TABLER,
+			expected: []string{"--tblr-primary: #ff6600;", "--tblr-primary-rgb: 255, 102, 0;", "--tblr-font-sans-serif: Inter, sans-serif;", "--tblr-border-radius: 0.75rem;"},
+		},
+		{
+			template: shared.TEMPLATE_ADMINLTE,
+			expected: []string{".btn-primary {", "background-color: #ff6600;", "font-family: Inter, sans-serif;", "border-radius: 0.75rem;"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(tt.template)
+			d.SetUser(types.User{FirstName: "Jane"})
+			d.AddBrandTheme(brand)
+
+			html := d.ToHTML()
+			if strings.Contains(html, "#ff6600;") {
+				t.Error("expected no brand theme CSS while the brand theme is not selected")
+			}
+
+			d.SetTheme("acme")
+			html = d.ToHTML()
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(html, expected) {
+					t.Errorf("expected rendered HTML to contain %q", expected)
+				}
+			}
+
+			if !strings.Contains(html, "?theme=acme") {
+				t.Error("expected brand theme to be listed in the theme switcher")
+			}
+		})
+	}
+}
+
+func TestBrandThemeInvalidTokens(t *testing.T) {
+	brand := types.BrandTheme{
+		ID:             "acme",
+		Name:           "Acme",
+		PrimaryColor:   "red;}</style><script>alert(1)</script>",
+		SecondaryColor: "#123456",
+		DangerColor:    "rgb(1, 2, 3)",
+		FontFamily:     "Inter;}body{display:none",
+		BorderRadius:   "1rem</style>",
+	}
+
+	templates := []string{shared.TEMPLATE_BOOTSTRAP, shared.TEMPLATE_TABLER, shared.TEMPLATE_ADMINLTE}
+
+	for _, template := range templates {
+		t.Run(template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(template)
+			d.AddBrandTheme(brand)
+			d.SetTheme("acme")
+
+			html := d.ToHTML()
+
+			for _, s := range []string{"<script>alert(1)", "display:none", "1rem</style>"} {
+				if strings.Contains(html, s) {
+					t.Errorf("expected HTML not to contain %q", s)
+				}
+			}
+
+			for _, s := range []string{"#123456", "rgb(1, 2, 3)"} {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain the valid token %q", s)
+				}
+			}
+		})
+	}
+}
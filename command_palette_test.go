@@ -0,0 +1,112 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	templateshared "github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func commandPaletteDashboard(template string) types.DashboardInterface {
+	d := dashboard.New()
+	d.SetTemplate(template)
+	d.SetUser(types.User{FirstName: "Jane"})
+	d.SetMenuMainItems([]types.MenuItem{
+		{Title: "Home", URL: "/"},
+		{Title: "Reports", URL: "#", Children: []types.MenuItem{
+			{Title: "Sales", URL: "/reports/sales"},
+			{Title: "Stock", Children: []types.MenuItem{
+				{Title: "Warehouse", URL: "/reports/stock/warehouse"},
+			}},
+		}},
+	})
+	d.SetMenuUserItems([]types.MenuItem{
+		{Title: "Profile", URL: "/profile"},
+		{Title: ""},
+		{Title: "Logout", URL: "/logout"},
+	})
+	d.SetMenuQuickAccessItems([]types.MenuItem{
+		{Title: "New Order", URL: "/orders/new", Target: "_blank"},
+	})
+	d.SetActions([]types.Action{
+		{Title: "Export", OnClick: "exportAll()"},
+		{Title: "Without handler"},
+	})
+	d.AddCommand(types.Command{Title: "Find order </script>", URL: "/orders/find", Keywords: []string{"lookup"}})
+	return d
+}
+
+func TestCommandPaletteCommands(t *testing.T) {
+	d := commandPaletteDashboard(shared.TEMPLATE_BOOTSTRAP)
+	commands := templateshared.CommandPaletteCommands(d)
+
+	expected := []struct {
+		title    string
+		category string
+	}{
+		{"Home", "Menu"},
+		{"Reports › Sales", "Menu"},
+		{"Reports › Stock › Warehouse", "Menu"},
+		{"New Order", "Quick Access"},
+		{"Profile", "User"},
+		{"Logout", "User"},
+		{"Export", "Actions"},
+		{"Find order </script>", "Commands"},
+	}
+
+	if len(commands) != len(expected) {
+		t.Fatalf("expected %d commands, got %d: %+v", len(expected), len(commands), commands)
+	}
+
+	for i, e := range expected {
+		if commands[i].Title != e.title || commands[i].Category != e.category {
+			t.Errorf("expected command %d to be %q in %q, got %q in %q", i, e.title, e.category, commands[i].Title, commands[i].Category)
+		}
+	}
+
+	if commands[3].Target != "_blank" {
+		t.Error("expected the menu item target to be kept")
+	}
+}
+
+func TestCommandPaletteRendering(t *testing.T) {
+	templates := []string{
+		shared.TEMPLATE_BOOTSTRAP,
+		shared.TEMPLATE_TABLER,
+		shared.TEMPLATE_ADMINLTE,
+	}
+
+	for _, template := range templates {
+		t.Run(template, func(t *testing.T) {
+			d := commandPaletteDashboard(template)
+
+			if html := d.ToHTML(); strings.Contains(html, `id="CommandPalette"`) {
+				t.Error("expected no command palette unless enabled")
+			}
+
+			d.SetCommandPaletteEnabled(true)
+			html := d.ToHTML()
+
+			expected := []string{
+				`id="CommandPalette"`,
+				`data-command-palette-input="true"`,
+				`data-command-palette-results="true"`,
+				`"title":"Reports › Stock › Warehouse"`,
+				`"onclick":"exportAll()"`,
+			}
+
+			for _, s := range expected {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+
+			if strings.Contains(html, "Find order </script>") {
+				t.Error("expected the command titles to be escaped inside the script")
+			}
+		})
+	}
+}
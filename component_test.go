@@ -0,0 +1,94 @@
+package dashboard_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	templatesshared "github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+type requestIDKey struct{}
+
+// greeting is a component with the method set of a templ component,
+// rendering the ID of the request found in the context
+type greeting struct {
+	id string
+}
+
+func (g greeting) Render(ctx context.Context, w io.Writer) error {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	_, err := fmt.Fprintf(w, `<span id="%s" data-request="%s"></span>`, g.id, requestID)
+	return err
+}
+
+func TestComponents(t *testing.T) {
+	templates := []string{
+		shared.TEMPLATE_BOOTSTRAP,
+		shared.TEMPLATE_TABLER,
+		shared.TEMPLATE_ADMINLTE,
+	}
+
+	for _, name := range templates {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r = r.WithContext(context.WithValue(r.Context(), requestIDKey{}, "req-42"))
+
+			d := dashboard.New()
+			d.SetTemplate(name)
+			d.SetHTTPRequest(r)
+			d.SetContentFragment(greeting{id: "content"})
+			d.AddRegionFragments(shared.REGION_CONTENT_BEFORE, greeting{id: "region"})
+			d.AddWidget(types.Widget{Title: "Sales <Q1>", Body: greeting{id: "widget"}, Columns: 6})
+			d.AddWidget(types.Widget{ID: "WidgetVisitors", Body: greeting{id: "widget-untitled"}})
+			d.AddModal(types.Modal{ID: "ComponentModal", Title: "Modal", Body: greeting{id: "modal"}})
+
+			html := d.ToHTML()
+
+			ids := []string{"content", "region", "widget", "widget-untitled"}
+			if name == shared.TEMPLATE_TABLER {
+				ids = append(ids, "modal")
+			}
+
+			for _, id := range ids {
+				expected := `<span id="` + id + `" data-request="req-42"></span>`
+				if !strings.Contains(html, expected) {
+					t.Errorf("expected the %s component to be rendered with the request context", id)
+				}
+			}
+
+			expected := []string{
+				`<div class="col-12 col-md-6 mb-3"><div class="card h-100"><div class="card-header"><h2 class="card-title h6 mb-0">Sales &lt;Q1&gt;</h2></div>`,
+				`<div class="col-12 col-md-12 mb-3"><div class="card h-100" id="WidgetVisitors"><div class="card-body">`,
+			}
+			for _, s := range expected {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+
+			if strings.Index(html, `id="widget"`) > strings.Index(html, `id="content"`) {
+				t.Error("expected the widgets above the content")
+			}
+
+			if strings.Contains(html, templatesshared.FRAGMENT_PLACEHOLDER_PREFIX) {
+				t.Error("expected all the placeholders to be replaced")
+			}
+		})
+	}
+}
+
+func TestComponentWithoutRequest(t *testing.T) {
+	d := dashboard.New()
+	d.SetContentFragment(greeting{id: "content"})
+
+	if !strings.Contains(d.ToHTML(), `<span id="content" data-request=""></span>`) {
+		t.Error("expected the component to be rendered with a background context")
+	}
+}
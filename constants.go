@@ -17,6 +17,160 @@ const TEMPLATE_ADMINLTE = shared.TEMPLATE_ADMINLTE
 const TEMPLATE_TABLER = shared.TEMPLATE_TABLER
 const TEMPLATE_BOOTSTRAP = shared.TEMPLATE_BOOTSTRAP
 
+// ============================================================================
+// Tabler layout constants
+// ============================================================================
+
+const TEMPLATE_TABLER_LAYOUT_HORIZONTAL = shared.TEMPLATE_TABLER_LAYOUT_HORIZONTAL
+const TEMPLATE_TABLER_LAYOUT_VERTICAL = shared.TEMPLATE_TABLER_LAYOUT_VERTICAL
+const TEMPLATE_TABLER_LAYOUT_VERTICAL_RIGHT = shared.TEMPLATE_TABLER_LAYOUT_VERTICAL_RIGHT
+const TEMPLATE_TABLER_LAYOUT_CONDENSED = shared.TEMPLATE_TABLER_LAYOUT_CONDENSED
+const TEMPLATE_TABLER_LAYOUT_COMBINED = shared.TEMPLATE_TABLER_LAYOUT_COMBINED
+const TEMPLATE_TABLER_LAYOUT_FLUID = shared.TEMPLATE_TABLER_LAYOUT_FLUID
+const TEMPLATE_TABLER_LAYOUT_FLUID_VERTICAL = shared.TEMPLATE_TABLER_LAYOUT_FLUID_VERTICAL
+const TEMPLATE_TABLER_LAYOUT_BOXED = shared.TEMPLATE_TABLER_LAYOUT_BOXED
+const TEMPLATE_TABLER_LAYOUT_NAVBAR_DARK = shared.TEMPLATE_TABLER_LAYOUT_NAVBAR_DARK
+const TEMPLATE_TABLER_LAYOUT_NAVBAR_OVERLAP = shared.TEMPLATE_TABLER_LAYOUT_NAVBAR_OVERLAP
+
+// ============================================================================
+// AdminLTE option constants
+// ============================================================================
+
+const TEMPLATE_ADMINLTE_SIDEBAR_SKIN_DARK = shared.TEMPLATE_ADMINLTE_SIDEBAR_SKIN_DARK
+const TEMPLATE_ADMINLTE_SIDEBAR_SKIN_LIGHT = shared.TEMPLATE_ADMINLTE_SIDEBAR_SKIN_LIGHT
+const TEMPLATE_ADMINLTE_TEXT_SIZE_DEFAULT = shared.TEMPLATE_ADMINLTE_TEXT_SIZE_DEFAULT
+const TEMPLATE_ADMINLTE_TEXT_SIZE_SMALL = shared.TEMPLATE_ADMINLTE_TEXT_SIZE_SMALL
+
+// ============================================================================
+// Page layout constants
+// ============================================================================
+
+const PAGE_LAYOUT_DASHBOARD = shared.PAGE_LAYOUT_DASHBOARD
+const PAGE_LAYOUT_AUTH = shared.PAGE_LAYOUT_AUTH
+const PAGE_LAYOUT_ERROR = shared.PAGE_LAYOUT_ERROR
+
+// ============================================================================
+// User status constants
+// ============================================================================
+
+const USER_STATUS_ONLINE = shared.USER_STATUS_ONLINE
+const USER_STATUS_AWAY = shared.USER_STATUS_AWAY
+const USER_STATUS_BUSY = shared.USER_STATUS_BUSY
+const USER_STATUS_OFFLINE = shared.USER_STATUS_OFFLINE
+
+// ============================================================================
+// Environment indicator constants
+// ============================================================================
+
+const ENVIRONMENT_VARIABLE = shared.ENVIRONMENT_VARIABLE
+const ENVIRONMENT_COLOR_VARIABLE = shared.ENVIRONMENT_COLOR_VARIABLE
+const ENVIRONMENT_DISPLAY_VARIABLE = shared.ENVIRONMENT_DISPLAY_VARIABLE
+const ENVIRONMENT_DISPLAY_RIBBON = shared.ENVIRONMENT_DISPLAY_RIBBON
+const ENVIRONMENT_DISPLAY_BADGE = shared.ENVIRONMENT_DISPLAY_BADGE
+
+// ============================================================================
+// Avatar shape constants
+// ============================================================================
+
+const AVATAR_SHAPE_CIRCLE = shared.AVATAR_SHAPE_CIRCLE
+const AVATAR_SHAPE_ROUNDED = shared.AVATAR_SHAPE_ROUNDED
+const AVATAR_SHAPE_SQUARE = shared.AVATAR_SHAPE_SQUARE
+
+// ============================================================================
+// Locale and message key constants
+// ============================================================================
+
+const LOCALE_DEFAULT = shared.LOCALE_DEFAULT
+
+// Text direction constants
+const DIRECTION_LTR = shared.DIRECTION_LTR
+const DIRECTION_RTL = shared.DIRECTION_RTL
+
+const MESSAGE_ACTIONS = shared.MESSAGE_ACTIONS
+const MESSAGE_ALREADY_REGISTERED = shared.MESSAGE_ALREADY_REGISTERED
+const MESSAGE_BACK_TO_HOME = shared.MESSAGE_BACK_TO_HOME
+const MESSAGE_BACK_TO_SIGN_IN = shared.MESSAGE_BACK_TO_SIGN_IN
+const MESSAGE_BACK_TO_TOP = shared.MESSAGE_BACK_TO_TOP
+const MESSAGE_BREADCRUMB = shared.MESSAGE_BREADCRUMB
+const MESSAGE_CLOSE = shared.MESSAGE_CLOSE
+const MESSAGE_COMMAND = shared.MESSAGE_COMMAND
+const MESSAGE_COMMANDS = shared.MESSAGE_COMMANDS
+const MESSAGE_COMMAND_HELP = shared.MESSAGE_COMMAND_HELP
+const MESSAGE_COMMAND_NO_RESULTS = shared.MESSAGE_COMMAND_NO_RESULTS
+const MESSAGE_COMMAND_PALETTE = shared.MESSAGE_COMMAND_PALETTE
+const MESSAGE_COMMAND_PLACEHOLDER = shared.MESSAGE_COMMAND_PLACEHOLDER
+const MESSAGE_CONFIRM_PASSWORD = shared.MESSAGE_CONFIRM_PASSWORD
+const MESSAGE_DASHBOARD = shared.MESSAGE_DASHBOARD
+const MESSAGE_EMAIL = shared.MESSAGE_EMAIL
+const MESSAGE_ERROR_401_MESSAGE = shared.MESSAGE_ERROR_401_MESSAGE
+const MESSAGE_ERROR_401_TITLE = shared.MESSAGE_ERROR_401_TITLE
+const MESSAGE_ERROR_403_MESSAGE = shared.MESSAGE_ERROR_403_MESSAGE
+const MESSAGE_ERROR_403_TITLE = shared.MESSAGE_ERROR_403_TITLE
+const MESSAGE_ERROR_404_MESSAGE = shared.MESSAGE_ERROR_404_MESSAGE
+const MESSAGE_ERROR_404_TITLE = shared.MESSAGE_ERROR_404_TITLE
+const MESSAGE_ERROR_500_MESSAGE = shared.MESSAGE_ERROR_500_MESSAGE
+const MESSAGE_ERROR_500_TITLE = shared.MESSAGE_ERROR_500_TITLE
+const MESSAGE_ERROR_503_MESSAGE = shared.MESSAGE_ERROR_503_MESSAGE
+const MESSAGE_ERROR_503_TITLE = shared.MESSAGE_ERROR_503_TITLE
+const MESSAGE_FORGOT_PASSWORD = shared.MESSAGE_FORGOT_PASSWORD
+const MESSAGE_FORGOT_PASSWORD_HELP = shared.MESSAGE_FORGOT_PASSWORD_HELP
+const MESSAGE_HOME = shared.MESSAGE_HOME
+const MESSAGE_IMPERSONATING = shared.MESSAGE_IMPERSONATING
+const MESSAGE_LOGIN = shared.MESSAGE_LOGIN
+const MESSAGE_MAINTENANCE_ETA = shared.MESSAGE_MAINTENANCE_ETA
+const MESSAGE_MAINTENANCE_MESSAGE = shared.MESSAGE_MAINTENANCE_MESSAGE
+const MESSAGE_MAINTENANCE_TITLE = shared.MESSAGE_MAINTENANCE_TITLE
+const MESSAGE_MAIN_NAVIGATION = shared.MESSAGE_MAIN_NAVIGATION
+const MESSAGE_MENU = shared.MESSAGE_MENU
+const MESSAGE_NAME = shared.MESSAGE_NAME
+const MESSAGE_NOT_AVAILABLE = shared.MESSAGE_NOT_AVAILABLE
+const MESSAGE_NOT_REGISTERED = shared.MESSAGE_NOT_REGISTERED
+const MESSAGE_PASSWORD = shared.MESSAGE_PASSWORD
+const MESSAGE_QUICK_ACCESS = shared.MESSAGE_QUICK_ACCESS
+const MESSAGE_REGISTER = shared.MESSAGE_REGISTER
+const MESSAGE_REMEMBER_ME = shared.MESSAGE_REMEMBER_ME
+const MESSAGE_REQUEST_ID = shared.MESSAGE_REQUEST_ID
+const MESSAGE_SEARCH = shared.MESSAGE_SEARCH
+const MESSAGE_SEARCH_NO_RESULTS = shared.MESSAGE_SEARCH_NO_RESULTS
+const MESSAGE_SEARCH_PLACEHOLDER = shared.MESSAGE_SEARCH_PLACEHOLDER
+const MESSAGE_SEE_ALL_MESSAGES = shared.MESSAGE_SEE_ALL_MESSAGES
+const MESSAGE_SEND_RESET_LINK = shared.MESSAGE_SEND_RESET_LINK
+const MESSAGE_SESSION_EXPIRING = shared.MESSAGE_SESSION_EXPIRING
+const MESSAGE_SESSION_EXPIRING_HELP = shared.MESSAGE_SESSION_EXPIRING_HELP
+const MESSAGE_SHOW_THEME_MENU = shared.MESSAGE_SHOW_THEME_MENU
+const MESSAGE_SIGNED_IN_AS = shared.MESSAGE_SIGNED_IN_AS
+const MESSAGE_SIGN_IN = shared.MESSAGE_SIGN_IN
+const MESSAGE_SKIP_TO_CONTENT = shared.MESSAGE_SKIP_TO_CONTENT
+const MESSAGE_STATUS_AWAY = shared.MESSAGE_STATUS_AWAY
+const MESSAGE_STATUS_BUSY = shared.MESSAGE_STATUS_BUSY
+const MESSAGE_STATUS_OFFLINE = shared.MESSAGE_STATUS_OFFLINE
+const MESSAGE_STATUS_ONLINE = shared.MESSAGE_STATUS_ONLINE
+const MESSAGE_STAY_SIGNED_IN = shared.MESSAGE_STAY_SIGNED_IN
+const MESSAGE_STOP_IMPERSONATING = shared.MESSAGE_STOP_IMPERSONATING
+const MESSAGE_THEME = shared.MESSAGE_THEME
+const MESSAGE_TOGGLE_NAVIGATION = shared.MESSAGE_TOGGLE_NAVIGATION
+const MESSAGE_TOGGLE_SIDEBAR = shared.MESSAGE_TOGGLE_SIDEBAR
+const MESSAGE_TOOLBAR = shared.MESSAGE_TOOLBAR
+const MESSAGE_TWO_FACTOR_CODE = shared.MESSAGE_TWO_FACTOR_CODE
+const MESSAGE_TWO_FACTOR_HELP = shared.MESSAGE_TWO_FACTOR_HELP
+const MESSAGE_USER = shared.MESSAGE_USER
+const MESSAGE_USER_MENU = shared.MESSAGE_USER_MENU
+const MESSAGE_VERIFY = shared.MESSAGE_VERIFY
+const MESSAGE_VIEW_ALL_NOTIFICATIONS = shared.MESSAGE_VIEW_ALL_NOTIFICATIONS
+
+// ============================================================================
+// Layout region constants
+// ============================================================================
+
+const REGION_NAVBAR_START = shared.REGION_NAVBAR_START
+const REGION_NAVBAR_END = shared.REGION_NAVBAR_END
+const REGION_SIDEBAR_TOP = shared.REGION_SIDEBAR_TOP
+const REGION_SIDEBAR_BOTTOM = shared.REGION_SIDEBAR_BOTTOM
+const REGION_PAGE_HEADER_END = shared.REGION_PAGE_HEADER_END
+const REGION_CONTENT_BEFORE = shared.REGION_CONTENT_BEFORE
+const REGION_CONTENT_AFTER = shared.REGION_CONTENT_AFTER
+const REGION_BODY_END = shared.REGION_BODY_END
+
 type Config struct {
 	types.Config
 }
@@ -1,7 +1,10 @@
 package dashboard
 
 import (
+	"context"
+	"log"
 	"net/http"
+	"strings"
 
 	"github.com/dracory/dashboard/shared"
 	"github.com/dracory/dashboard/templates/adminlte"
@@ -12,53 +15,74 @@ import (
 )
 
 type dashboard struct {
-	content                   string
-	subtitle                  string         // page subtitle
-	actions                   []types.Action // header action buttons
-	alerts                    []types.Alert  // alert messages to display
-	modals                    []types.Modal  // modal dialogs
+	content                   types.HTML
+	subtitle                  string          // page subtitle
+	actions                   []types.Action  // header action buttons
+	alerts                    []types.Alert   // alert messages to display
+	modals                    []types.Modal   // modal dialogs
+	widgets                   []types.Widget  // cards of the widget grid
+	commands                  []types.Command // extra commands of the command palette
+	commandPaletteEnabled     bool            // whether the Ctrl+K command palette is rendered
 	faviconURL                string
 	layout                    string // layout: some templates support different layouts
+	pageLayout                string // page layout: dashboard (default), auth or error
+	adminlteConfig            types.AdminLTEConfig
 	logoImageURL              string
-	logoRawHtml               string
+	logoRawHtml               types.HTML
 	logoRedirectURL           string
 	menuMainItems             []types.MenuItem
 	menuShowText              bool   // controls whether to show text in menu items
-	menuType                  string // modal or offcanvas
+	menuType                  string // modal, offcanvas or sidebar
 	menuUserItems             []types.MenuItem
 	menuQuickAccessItems      []types.MenuItem
 	navbarBackgroundColorMode string
 	navbarBackgroundColor     string
 	navbarTextColor           string
-	redirectTime              string                 // redirect time (if any, in seconds)
-	redirectUrl               string                 // redirect URL (if any)
-	scripts                   []string               // custom scripts defined by the user
-	scriptURLs                []string               // custom script URLs defined by the user
-	sidebarCollapsed          bool                   // whether the sidebar is collapsed
-	breadcrumb                []types.BreadcrumbItem // breadcrumb navigation items
-	styles                    []string               // custom styles defined by the user
-	styleURLs                 []string               // custom style URLs defined by the user
-	theme                     string                 // color mode: default, dark, light
-	themesRestrict            map[string]string      // restricted theme options
-	themeHandlerUrl           string                 // URL for theme handler
-	template                  string                 // bootstrap (default), adminlte, tabler
-	title                     string                 // title of the webpage
-	user                      *types.User            // user object (if any)
-	loginURL                  string                 // login URL
-	registerURL               string                 // register URL
+	redirectTime              string                    // redirect time (if any, in seconds)
+	redirectUrl               string                    // redirect URL (if any)
+	scripts                   []string                  // custom scripts defined by the user
+	scriptURLs                []string                  // custom script URLs defined by the user
+	sidebarCollapsed          bool                      // whether the sidebar is collapsed
+	sidebarHandlerUrl         string                    // URL for sidebar state handler
+	searchHandlerUrl          string                    // URL for search handler, search is hidden when empty
+	locale                    string                    // locale of the UI strings and the html lang attribute
+	translator                types.TranslatorInterface // translator of the built-in UI strings
+	direction                 string                    // text direction, "ltr" or "rtl", derived from the locale if empty
+	breadcrumb                []types.BreadcrumbItem    // breadcrumb navigation items
+	footer                    *types.Footer             // page footer, omitted when nil
+	contentFragment           types.Fragment            // content written while rendering, takes precedence over content
+	ctx                       context.Context           // context of the HTTP request, passed to the fragments
+	fragmentToken             string                    // random token of the fragment placeholders, generated by Render
+	styles                    []string                  // custom styles defined by the user
+	styleURLs                 []string                  // custom style URLs defined by the user
+	theme                     string                    // color mode: default, dark, light
+	themesRestrict            map[string]string         // restricted theme options
+	themeDefault              string                    // theme used when the selected theme is not allowed
+	brandThemes               []types.BrandTheme        // custom themes built from design tokens
+	themeHandlerUrl           string                    // URL for theme handler
+	template                  string                    // bootstrap (default), adminlte, tabler
+	title                     string                    // title of the webpage
+	user                      *types.User               // user object (if any)
+	impersonation             *types.Impersonation      // real user acting as the user, nil when not impersonating
+	environment               types.Environment         // environment indicator, hidden when the name is empty
+	sessionTimeout            *types.SessionTimeout     // session timeout countdown, omitted when nil
+	loginURL                  string                    // login URL
+	registerURL               string                    // register URL
+
+	regions map[string][]types.Fragment // fragments of the layout regions, by region name
 }
 
 var _ types.DashboardInterface = (*dashboard)(nil)
 
-// ToHTML returns the HTML of the dashboard
+// ToHTML returns the HTML of the dashboard. The output stops at the first
+// fragment failing to render, the error is logged, use Render to handle
+// the error.
 func (d *dashboard) ToHTML() string {
-	templateName, template := d.findTemplate()
-
-	if template == nil {
-		return "Template not found: " + templateName
+	var sb strings.Builder
+	if err := d.Render(&sb); err != nil {
+		log.Println(err.Error())
 	}
-
-	return template.ToHTML(d)
+	return sb.String()
 }
 
 // findTemplate returns the template name and template instance
@@ -98,6 +122,48 @@ func (d *dashboard) SetSidebarCollapsed(collapsed bool) {
 	d.sidebarCollapsed = collapsed
 }
 
+// GetSidebarHandlerUrl returns the URL for the sidebar state handler endpoint
+func (d *dashboard) GetSidebarHandlerUrl() string {
+	if d.sidebarHandlerUrl == "" {
+		return "/sidebar"
+	}
+	return d.sidebarHandlerUrl
+}
+
+// SetSidebarHandlerUrl sets the URL for the sidebar state handler endpoint
+func (d *dashboard) SetSidebarHandlerUrl(url string) {
+	d.sidebarHandlerUrl = url
+}
+
+// GetSearchHandlerUrl returns the URL for the search handler endpoint,
+// the navbar search box is only rendered when it is set
+func (d *dashboard) GetSearchHandlerUrl() string {
+	return d.searchHandlerUrl
+}
+
+// SetSearchHandlerUrl sets the URL for the search handler endpoint
+func (d *dashboard) SetSearchHandlerUrl(url string) {
+	d.searchHandlerUrl = url
+}
+
+// ============================================================================
+// == Footer Methods
+// ============================================================================
+
+// GetFooter returns the page footer, or nil when the footer is not set
+// or has nothing to render
+func (d *dashboard) GetFooter() *types.Footer {
+	if d.footer == nil || d.footer.IsEmpty() {
+		return nil
+	}
+	return d.footer
+}
+
+// SetFooter sets the page footer
+func (d *dashboard) SetFooter(footer types.Footer) {
+	d.footer = &footer
+}
+
 // ============================================================================
 // == Breadcrumb Methods
 // ============================================================================
@@ -196,20 +262,159 @@ func (d *dashboard) ClearModals() {
 	d.modals = []types.Modal{}
 }
 
+// ============================================================================
+// == Widget Methods
+// ============================================================================
+
+// GetWidgets returns the widgets of the widget grid
+func (d *dashboard) GetWidgets() []types.Widget {
+	if d.widgets == nil {
+		return []types.Widget{}
+	}
+	return d.widgets
+}
+
+// AddWidget adds a widget to the widget grid shown above the content
+func (d *dashboard) AddWidget(widget types.Widget) {
+	if d.widgets == nil {
+		d.widgets = []types.Widget{}
+	}
+	d.widgets = append(d.widgets, widget)
+}
+
+// ClearWidgets removes all widgets
+func (d *dashboard) ClearWidgets() {
+	d.widgets = []types.Widget{}
+}
+
+// ============================================================================
+// == Localization Methods
+// ============================================================================
+
+// GetLocale returns the locale of the dashboard, e.g. "en" or "de-AT"
+func (d *dashboard) GetLocale() string {
+	if d.locale == "" {
+		return shared.LOCALE_DEFAULT
+	}
+	return d.locale
+}
+
+// SetLocale sets the locale of the dashboard
+func (d *dashboard) SetLocale(locale string) {
+	d.locale = locale
+}
+
+// GetDirection returns the text direction of the dashboard, DIRECTION_LTR
+// or DIRECTION_RTL. Unless set, it follows the locale, e.g. "ar" is RTL
+func (d *dashboard) GetDirection() string {
+	if d.direction == "" {
+		return shared.LocaleDirection(d.GetLocale())
+	}
+	return d.direction
+}
+
+// SetDirection sets the text direction of the dashboard, DIRECTION_LTR or
+// DIRECTION_RTL, overriding the direction of the locale
+func (d *dashboard) SetDirection(direction string) {
+	d.direction = direction
+}
+
+// IsRTL returns true if the dashboard is laid out from right to left
+func (d *dashboard) IsRTL() bool {
+	return d.GetDirection() == shared.DIRECTION_RTL
+}
+
+// GetTranslator returns the translator of the built-in UI strings
+func (d *dashboard) GetTranslator() types.TranslatorInterface {
+	return d.translator
+}
+
+// SetTranslator sets the translator of the built-in UI strings,
+// e.g. a MessageCatalog
+func (d *dashboard) SetTranslator(translator types.TranslatorInterface) {
+	d.translator = translator
+}
+
+// Translate returns the built-in UI string for the message key in the
+// locale of the dashboard, falling back to the English default
+func (d *dashboard) Translate(key string) string {
+	if d.translator != nil {
+		if message := d.translator.Translate(d.GetLocale(), key); message != "" {
+			return message
+		}
+	}
+
+	return shared.MessageDefault(key)
+}
+
+// ============================================================================
+// == Command Palette Methods
+// ============================================================================
+
+// GetCommandPaletteEnabled returns whether the command palette is rendered
+func (d *dashboard) GetCommandPaletteEnabled() bool {
+	return d.commandPaletteEnabled
+}
+
+// SetCommandPaletteEnabled sets whether the command palette is rendered,
+// the palette opens with Ctrl+K (Cmd+K on macOS)
+func (d *dashboard) SetCommandPaletteEnabled(enabled bool) {
+	d.commandPaletteEnabled = enabled
+}
+
+// GetCommands returns the extra commands of the command palette
+func (d *dashboard) GetCommands() []types.Command {
+	if d.commands == nil {
+		return []types.Command{}
+	}
+	return d.commands
+}
+
+// AddCommand adds an extra command to the command palette, next to
+// the menu items and actions which are indexed automatically
+func (d *dashboard) AddCommand(command types.Command) {
+	if d.commands == nil {
+		d.commands = []types.Command{}
+	}
+	d.commands = append(d.commands, command)
+}
+
+// ClearCommands removes all extra commands
+func (d *dashboard) ClearCommands() {
+	d.commands = []types.Command{}
+}
+
 // ============================================================================
 // == Getters and Setters
 // ============================================================================
 
 // GetContent returns the content of the webpage
-func (d *dashboard) GetContent() string {
+func (d *dashboard) GetContent() types.HTML {
 	return d.content
 }
 
 // SetContent sets the content of the webpage
-func (d *dashboard) SetContent(content string) {
+func (d *dashboard) SetContent(content types.HTML) {
 	d.content = content
 }
 
+// GetContentFragment returns the content fragment of the webpage
+func (d *dashboard) GetContentFragment() types.Fragment {
+	return d.contentFragment
+}
+
+// SetContentFragment sets the content of the webpage as a fragment,
+// written while rendering, it takes precedence over SetContent
+func (d *dashboard) SetContentFragment(content types.Fragment) {
+	d.contentFragment = content
+}
+
+// GetFragmentToken returns the random token of the fragment placeholders,
+// generated by Render for each render
+func (d *dashboard) GetFragmentToken() string {
+	return d.fragmentToken
+}
+
 // GetFaviconURL returns the favicon URL of the webpage
 func (d *dashboard) GetFaviconURL() string {
 	return d.faviconURL
@@ -231,12 +436,12 @@ func (d *dashboard) SetLogoImageURL(logoImageURL string) {
 }
 
 // GetLogoRawHtml returns the logo raw HTML of the webpage
-func (d *dashboard) GetLogoRawHtml() string {
+func (d *dashboard) GetLogoRawHtml() types.HTML {
 	return d.logoRawHtml
 }
 
 // SetLogoRawHtml sets the logo raw HTML of the webpage
-func (d *dashboard) SetLogoRawHtml(logoRawHtml string) {
+func (d *dashboard) SetLogoRawHtml(logoRawHtml types.HTML) {
 	d.logoRawHtml = logoRawHtml
 }
 
@@ -250,6 +455,52 @@ func (d *dashboard) SetLogoRedirectURL(logoRedirectURL string) {
 	d.logoRedirectURL = logoRedirectURL
 }
 
+// GetLayout returns the layout of the template (if supported by the template)
+func (d *dashboard) GetLayout() string {
+	return d.layout
+}
+
+// SetLayout sets the layout of the template (if supported by the template)
+func (d *dashboard) SetLayout(layout string) {
+	d.layout = layout
+}
+
+// GetPageLayout returns the page layout, dashboard (default), auth or error
+func (d *dashboard) GetPageLayout() string {
+	if d.pageLayout == "" {
+		return shared.PAGE_LAYOUT_DASHBOARD
+	}
+	return d.pageLayout
+}
+
+// SetPageLayout sets the page layout, the auth and error layouts render the
+// content in a centred card, without the navigation
+func (d *dashboard) SetPageLayout(pageLayout string) {
+	d.pageLayout = pageLayout
+}
+
+// GetTablerConfig returns the configuration specific to the tabler template
+func (d *dashboard) GetTablerConfig() types.TablerConfig {
+	return types.TablerConfig{
+		Layout: d.layout,
+	}
+}
+
+// SetTablerConfig sets the configuration specific to the tabler template
+func (d *dashboard) SetTablerConfig(config types.TablerConfig) {
+	d.layout = config.Layout
+}
+
+// GetAdminLTEConfig returns the configuration specific to the adminlte template
+func (d *dashboard) GetAdminLTEConfig() types.AdminLTEConfig {
+	return d.adminlteConfig
+}
+
+// SetAdminLTEConfig sets the configuration specific to the adminlte template
+func (d *dashboard) SetAdminLTEConfig(config types.AdminLTEConfig) {
+	d.adminlteConfig = config
+}
+
 // GetMenuMainItems returns the menu items for the main menu
 func (d *dashboard) GetMenuMainItems() []types.MenuItem {
 	return d.menuMainItems
@@ -383,6 +634,106 @@ func (d *dashboard) SetUser(user types.User) {
 	d.user = &user
 }
 
+// ============================================================================
+// == Impersonation Methods
+// ============================================================================
+
+// GetImpersonation returns the impersonation, the real user acting as the
+// user of the dashboard, or nil when not impersonating
+func (d *dashboard) GetImpersonation() *types.Impersonation {
+	return d.impersonation
+}
+
+// SetImpersonation sets the impersonation, the user set with SetUser is
+// the effective user shown in the user menu
+func (d *dashboard) SetImpersonation(impersonation types.Impersonation) {
+	d.impersonation = &impersonation
+}
+
+// ClearImpersonation removes the impersonation
+func (d *dashboard) ClearImpersonation() {
+	d.impersonation = nil
+}
+
+// ============================================================================
+// == Environment Methods
+// ============================================================================
+
+// GetEnvironment returns the environment indicator, or nil when the
+// environment has no name
+func (d *dashboard) GetEnvironment() *types.Environment {
+	if d.environment.Name == "" {
+		return nil
+	}
+	environment := d.environment
+	return &environment
+}
+
+// SetEnvironment sets the environment indicator, e.g. EnvironmentFromEnv(),
+// an empty name hides the indicator
+func (d *dashboard) SetEnvironment(environment types.Environment) {
+	d.environment = environment
+}
+
+// ============================================================================
+// == Session Timeout Methods
+// ============================================================================
+
+// GetSessionTimeout returns the session timeout countdown, or nil when the
+// session expiry is not set
+func (d *dashboard) GetSessionTimeout() *types.SessionTimeout {
+	if d.sessionTimeout == nil || d.sessionTimeout.ExpiresAt.IsZero() {
+		return nil
+	}
+	return d.sessionTimeout
+}
+
+// SetSessionTimeout sets the session timeout countdown, the modal is shown
+// before the session expires and the page is redirected to the login URL
+// when it does
+func (d *dashboard) SetSessionTimeout(sessionTimeout types.SessionTimeout) {
+	d.sessionTimeout = &sessionTimeout
+}
+
+// GetNavbarFragments returns the extras rendered at the end of the navbar,
+// the fragments of the navbar-end region
+func (d *dashboard) GetNavbarFragments() []types.Fragment {
+	return d.GetRegionFragments(shared.REGION_NAVBAR_END)
+}
+
+// AddNavbarFragment adds an extra rendered at the end of the navbar,
+// to the navbar-end region
+func (d *dashboard) AddNavbarFragment(fragment types.Fragment) {
+	d.AddRegionFragments(shared.REGION_NAVBAR_END, fragment)
+}
+
+// ============================================================================
+// == Layout Region Methods
+// ============================================================================
+
+// GetRegionFragments returns the fragments of the layout region,
+// in the order in which they were added
+func (d *dashboard) GetRegionFragments(region string) []types.Fragment {
+	if d.regions[region] == nil {
+		return []types.Fragment{}
+	}
+	return d.regions[region]
+}
+
+// AddRegionFragments appends fragments to the layout region,
+// see the shared.REGION_* constants for the regions
+func (d *dashboard) AddRegionFragments(region string, fragments ...types.Fragment) {
+	if d.regions == nil {
+		d.regions = map[string][]types.Fragment{}
+	}
+	d.regions[region] = append(d.regions[region], fragments...)
+}
+
+// ClearRegion removes all the fragments of the layout region
+func (d *dashboard) ClearRegion(region string) {
+	delete(d.regions, region)
+}
+
 // Navbar theming methods
 func (d *dashboard) GetNavbarBackgroundColorMode() string {
 	return d.navbarBackgroundColorMode
@@ -427,15 +778,26 @@ func (d *dashboard) SetRegisterURL(url string) {
 
 // Theme methods
 func (d *dashboard) IsThemeDark() bool {
-	// Check if the theme is in the list of dark themes
+	// Prefer the dark flag from the theme catalog of the active template
+	themeName := d.GetTheme()
+	if theme, found := lo.Find(d.GetThemes(), func(theme types.Theme) bool { return theme.ID == themeName }); found {
+		return theme.IsDark
+	}
+
+	// Otherwise check if the theme is in the list of dark themes
 	darkThemes := []string{
 		"cyborg", "darkly", "slate", "solar", "superhero", "vapor", "dark",
 	}
 
-	return lo.Contains(darkThemes, d.theme)
+	return lo.Contains(darkThemes, themeName)
 }
 
+// GetTheme returns the theme, or the default theme if the
+// theme is not allowed by the theme restrictions
 func (d *dashboard) GetTheme() string {
+	if !d.isThemeAllowed(d.theme) {
+		return d.GetThemeDefault()
+	}
 	return d.theme
 }
 
@@ -444,12 +806,21 @@ func (d *dashboard) SetTheme(theme string) {
 }
 
 // SetHTTPRequest updates dashboard settings based on the provided HTTP request.
-// Currently it derives the theme from the context (set by ThemeMiddleware) or cookie.
+// It derives the theme and the sidebar state from the context (set by
+// ThemeMiddleware and SidebarMiddleware) or from the cookies.
 func (d *dashboard) SetHTTPRequest(r *http.Request) {
 	if r == nil {
 		return
 	}
 
+	d.ctx = r.Context()
+	d.setThemeFromRequest(r)
+	d.setSidebarCollapsedFromRequest(r)
+	d.setLocaleFromRequest(r)
+}
+
+// setThemeFromRequest sets the theme from the context or the theme cookie
+func (d *dashboard) setThemeFromRequest(r *http.Request) {
 	if themeName, ok := r.Context().Value(shared.ThemeNameContextKey{}).(string); ok && themeName != "" {
 		d.SetTheme(themeName)
 		return
@@ -460,6 +831,25 @@ func (d *dashboard) SetHTTPRequest(r *http.Request) {
 	}
 }
 
+// setSidebarCollapsedFromRequest sets the sidebar state from the context or the sidebar cookie
+func (d *dashboard) setSidebarCollapsedFromRequest(r *http.Request) {
+	if collapsed, ok := r.Context().Value(shared.SidebarCollapsedContextKey{}).(bool); ok {
+		d.SetSidebarCollapsed(collapsed)
+		return
+	}
+
+	if collapsed, found := SidebarCollapsedRetrieveFromCookie(r); found {
+		d.SetSidebarCollapsed(collapsed)
+	}
+}
+
+// setLocaleFromRequest sets the locale negotiated by the locale middleware
+func (d *dashboard) setLocaleFromRequest(r *http.Request) {
+	if locale, ok := r.Context().Value(shared.LocaleContextKey{}).(string); ok && locale != "" {
+		d.SetLocale(locale)
+	}
+}
+
 // GetThemeHandlerUrl returns the URL for the theme handler endpoint
 func (d *dashboard) GetThemeHandlerUrl() string {
 	if d.themeHandlerUrl == "" {
@@ -473,9 +863,68 @@ func (d *dashboard) SetThemeHandlerUrl(url string) {
 	d.themeHandlerUrl = url
 }
 
-// GetThemesRestrict returns the map of restricted themes
+// GetThemes returns the theme catalog of the active template,
+// followed by the brand themes
+func (d *dashboard) GetThemes() []types.Theme {
+	_, template := d.findTemplate()
+
+	catalog, ok := template.(types.ThemeCatalogInterface)
+	if !ok {
+		return []types.Theme{}
+	}
+
+	themes := catalog.Themes()
+
+	// Brand themes are built on top of the stylesheet of the default theme
+	base, _ := lo.Find(themes, func(theme types.Theme) bool { return theme.ID == catalog.ThemeDefault() })
+
+	for _, brand := range d.GetBrandThemes() {
+		background := lo.Ternary(brand.IsDark, "#212529", "#ffffff")
+		themes = append(themes, types.Theme{
+			ID:               brand.ID,
+			Name:             lo.Ternary(brand.Name != "", brand.Name, brand.ID),
+			IsDark:           brand.IsDark,
+			PreviewColors:    []string{brand.PrimaryColor, background},
+			StylesheetURL:    base.StylesheetURL,
+			StylesheetURLRTL: base.StylesheetURLRTL,
+		})
+	}
+
+	return themes
+}
+
+// GetBrandThemes returns the custom themes built from design tokens
+func (d *dashboard) GetBrandThemes() []types.BrandTheme {
+	if d.brandThemes == nil {
+		return []types.BrandTheme{}
+	}
+	return d.brandThemes
+}
+
+// AddBrandTheme registers a custom theme built from design tokens,
+// a brand theme with the same ID as an existing one replaces it
+func (d *dashboard) AddBrandTheme(theme types.BrandTheme) {
+	d.brandThemes = lo.Reject(d.brandThemes, func(brand types.BrandTheme, _ int) bool {
+		return brand.ID == theme.ID
+	})
+	d.brandThemes = append(d.brandThemes, theme)
+}
+
+// GetThemesRestrict returns the map of restricted themes, keeping only
+// the themes found in the theme catalog of the active template
 func (d *dashboard) GetThemesRestrict() map[string]string {
-	return d.themesRestrict
+	if d.themesRestrict == nil {
+		return nil
+	}
+
+	themes := d.GetThemes()
+	if len(themes) == 0 {
+		return d.themesRestrict
+	}
+
+	return lo.PickBy(d.themesRestrict, func(themeID string, _ string) bool {
+		return lo.ContainsBy(themes, func(theme types.Theme) bool { return theme.ID == themeID })
+	})
 }
 
 // SetThemesRestrict sets the map of restricted themes
@@ -483,6 +932,69 @@ func (d *dashboard) SetThemesRestrict(themes map[string]string) {
 	d.themesRestrict = themes
 }
 
+// GetThemesAvailable returns the themes offered to the user: the theme catalog
+// of the active template, limited to the restricted themes (if any) and
+// using the display names from the restrictions map when supplied
+func (d *dashboard) GetThemesAvailable() []types.Theme {
+	themes := d.GetThemes()
+	restrict := d.GetThemesRestrict()
+
+	if len(restrict) == 0 {
+		return themes
+	}
+
+	available := []types.Theme{}
+	for _, theme := range themes {
+		label, ok := restrict[theme.ID]
+		if !ok {
+			continue
+		}
+		if label != "" {
+			theme.Name = label
+		}
+		available = append(available, theme)
+	}
+
+	return available
+}
+
+// GetThemeDefault returns the theme to use when the selected theme is not allowed.
+// This is the configured default theme if allowed, then the default theme of the
+// active template if allowed, and finally the first available theme
+func (d *dashboard) GetThemeDefault() string {
+	if d.themeDefault != "" && d.isThemeAllowed(d.themeDefault) {
+		return d.themeDefault
+	}
+
+	_, template := d.findTemplate()
+	if catalog, ok := template.(types.ThemeCatalogInterface); ok && d.isThemeAllowed(catalog.ThemeDefault()) {
+		return catalog.ThemeDefault()
+	}
+
+	if available := d.GetThemesAvailable(); len(available) > 0 {
+		return available[0].ID
+	}
+
+	return d.themeDefault
+}
+
+// SetThemeDefault sets the theme to use when the selected theme is not allowed
+func (d *dashboard) SetThemeDefault(theme string) {
+	d.themeDefault = theme
+}
+
+// isThemeAllowed checks the theme against the theme restrictions,
+// all themes are allowed when there are no (valid) restrictions
+func (d *dashboard) isThemeAllowed(theme string) bool {
+	restrict := d.GetThemesRestrict()
+	if len(restrict) == 0 {
+		return true
+	}
+
+	_, ok := restrict[theme]
+	return ok
+}
+
 // GetMenuShowText returns whether to show text in menu items
 func (d *dashboard) GetMenuShowText() bool {
 	return d.menuShowText
@@ -0,0 +1,105 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestLocaleDirection(t *testing.T) {
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{locale: "", expected: shared.DIRECTION_LTR},
+		{locale: "en", expected: shared.DIRECTION_LTR},
+		{locale: "de-AT", expected: shared.DIRECTION_LTR},
+		{locale: "ar", expected: shared.DIRECTION_RTL},
+		{locale: "he-IL", expected: shared.DIRECTION_RTL},
+		{locale: "fa_IR", expected: shared.DIRECTION_RTL},
+		{locale: "UR", expected: shared.DIRECTION_RTL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if direction := shared.LocaleDirection(tt.locale); direction != tt.expected {
+				t.Errorf("expected direction %q, got %q", tt.expected, direction)
+			}
+		})
+	}
+}
+
+func TestDirection(t *testing.T) {
+	d := dashboard.New()
+	if d.IsRTL() {
+		t.Error("expected the default direction to be left-to-right")
+	}
+
+	d.SetLocale("ar")
+	if !d.IsRTL() {
+		t.Error("expected an Arabic locale to be right-to-left")
+	}
+
+	d.SetDirection(dashboard.DIRECTION_LTR)
+	if d.IsRTL() {
+		t.Error("expected the set direction to override the locale")
+	}
+}
+
+func TestRTLRendering(t *testing.T) {
+	tests := []struct {
+		template    string
+		expected    []string
+		notExpected []string
+	}{
+		{
+			template:    shared.TEMPLATE_BOOTSTRAP,
+			expected:    []string{"bootstrap@5.3.3/dist/css/bootstrap.rtl.min.css"},
+			notExpected: []string{"bootstrap@5.3.3/dist/css/bootstrap.min.css", "margin-left:10px"},
+		},
+		{
+			template:    shared.TEMPLATE_TABLER,
+			expected:    []string{"dist/css/tabler.rtl.min.css"},
+			notExpected: []string{"dist/css/tabler.min.css"},
+		},
+		{
+			template: shared.TEMPLATE_ADMINLTE,
+			expected: []string{`[dir="rtl"] .main-sidebar`, "navbar-nav ml-auto"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(tt.template)
+			d.SetUser(types.User{FirstName: "Jane"})
+			d.SetThemeHandlerUrl("/theme")
+			d.SetDirection(dashboard.DIRECTION_RTL)
+			html := d.ToHTML()
+
+			if !strings.Contains(html, `dir="rtl"`) {
+				t.Error(`expected the html element to have dir="rtl"`)
+			}
+			for _, expected := range tt.expected {
+				if !strings.Contains(html, expected) {
+					t.Errorf("expected %q in the page", expected)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(html, notExpected) {
+					t.Errorf("did not expect %q in the page", notExpected)
+				}
+			}
+
+			// Left-to-right pages load the regular stylesheets
+			d.SetDirection(dashboard.DIRECTION_LTR)
+			html = d.ToHTML()
+			if !strings.Contains(html, `dir="ltr"`) || strings.Contains(html, ".rtl.min.css") || strings.Contains(html, `[dir="rtl"]`) {
+				t.Error("expected a left-to-right page")
+			}
+		})
+	}
+}
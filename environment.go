@@ -0,0 +1,24 @@
+package dashboard
+
+import (
+	"os"
+	"strings"
+
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// EnvironmentFromEnv returns the environment configured with the
+// DASHBOARD_ENVIRONMENT, DASHBOARD_ENVIRONMENT_COLOR and
+// DASHBOARD_ENVIRONMENT_DISPLAY environment variables, e.g.
+// DASHBOARD_ENVIRONMENT=staging. Set it as the environment of the
+// dashboard, so the same build shows the environment it runs in:
+//
+//	d.SetEnvironment(dashboard.EnvironmentFromEnv())
+func EnvironmentFromEnv() types.Environment {
+	return types.Environment{
+		Name:    strings.TrimSpace(os.Getenv(shared.ENVIRONMENT_VARIABLE)),
+		Color:   strings.TrimSpace(os.Getenv(shared.ENVIRONMENT_COLOR_VARIABLE)),
+		Display: strings.TrimSpace(os.Getenv(shared.ENVIRONMENT_DISPLAY_VARIABLE)),
+	}
+}
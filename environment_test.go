@@ -0,0 +1,129 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestEnvironmentFromEnv(t *testing.T) {
+	t.Setenv(shared.ENVIRONMENT_VARIABLE, " staging ")
+	t.Setenv(shared.ENVIRONMENT_COLOR_VARIABLE, "#123456")
+	t.Setenv(shared.ENVIRONMENT_DISPLAY_VARIABLE, shared.ENVIRONMENT_DISPLAY_BADGE)
+
+	expected := types.Environment{Name: "staging", Color: "#123456", Display: shared.ENVIRONMENT_DISPLAY_BADGE}
+	if environment := dashboard.EnvironmentFromEnv(); environment != expected {
+		t.Errorf("expected %+v, got %+v", expected, environment)
+	}
+
+	d := dashboard.New()
+	if environment := d.GetEnvironment(); environment != nil {
+		t.Errorf("expected New not to read the environment variables, got %+v", environment)
+	}
+
+	d.SetEnvironment(dashboard.EnvironmentFromEnv())
+	if environment := d.GetEnvironment(); environment == nil || *environment != expected {
+		t.Errorf("expected the environment of the variables, got %+v", environment)
+	}
+
+	d.SetEnvironment(types.Environment{})
+	if d.GetEnvironment() != nil {
+		t.Error("expected an environment without a name to be hidden")
+	}
+}
+
+func TestEnvironmentRendering(t *testing.T) {
+	templates := []string{shared.TEMPLATE_BOOTSTRAP, shared.TEMPLATE_TABLER, shared.TEMPLATE_ADMINLTE}
+
+	tests := []struct {
+		name        string
+		environment types.Environment
+		setup       func(d types.DashboardInterface)
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name:        "none",
+			notExpected: []string{"dashboard-environment", "<title>[", "globalCompositeOperation"},
+		},
+		{
+			name:        "ribbon",
+			environment: types.Environment{Name: "staging"},
+			expected: []string{
+				`<title>[STAGING] Orders &amp; Invoices</title>`,
+				`class="dashboard-environment dashboard-environment-ribbon"`,
+				`background:#f76707;">staging</div>`,
+				`right:-48px;transform:rotate(45deg);`,
+				`var color = "#f76707";`,
+			},
+			notExpected: []string{"dashboard-environment-badge"},
+		},
+		{
+			name:        "badge",
+			environment: types.Environment{Name: "production", Display: shared.ENVIRONMENT_DISPLAY_BADGE},
+			expected: []string{
+				`<title>[PRODUCTION] Orders &amp; Invoices</title>`,
+				`class="badge dashboard-environment dashboard-environment-badge`,
+				`background:#d63939;">production</span>`,
+			},
+			notExpected: []string{"dashboard-environment-ribbon"},
+		},
+		{
+			name:        "custom color",
+			environment: types.Environment{Name: "Demo", Color: "rgb(1, 2, 3)"},
+			expected:    []string{`background:rgb(1, 2, 3);">Demo</div>`, `<title>[DEMO] Orders &amp; Invoices</title>`},
+		},
+		{
+			name:        "invalid color",
+			environment: types.Environment{Name: "<demo>", Color: "red;position:static"},
+			expected:    []string{`background:#6c757d;">&lt;demo&gt;</div>`, `<title>[&lt;DEMO&gt;] Orders`},
+			notExpected: []string{"position:static", "<demo>"},
+		},
+		{
+			name:        "right to left",
+			environment: types.Environment{Name: "dev"},
+			setup: func(d types.DashboardInterface) {
+				d.SetLocale("ar")
+			},
+			expected: []string{`left:-48px;transform:rotate(-45deg);`},
+		},
+		{
+			name:        "standalone badge",
+			environment: types.Environment{Name: "dev", Display: shared.ENVIRONMENT_DISPLAY_BADGE},
+			setup: func(d types.DashboardInterface) {
+				d.SetPageLayout(shared.PAGE_LAYOUT_AUTH)
+			},
+			expected: []string{"dashboard-environment-ribbon", `<title>[DEV] Orders &amp; Invoices</title>`},
+		},
+	}
+
+	for _, template := range templates {
+		for _, tt := range tests {
+			t.Run(template+" "+tt.name, func(t *testing.T) {
+				d := dashboard.New()
+				d.SetTemplate(template)
+				d.SetTitle("Orders & Invoices")
+				d.SetEnvironment(tt.environment)
+				if tt.setup != nil {
+					tt.setup(d)
+				}
+
+				html := d.ToHTML()
+
+				for _, s := range tt.expected {
+					if !strings.Contains(html, s) {
+						t.Errorf("expected HTML to contain %q", s)
+					}
+				}
+				for _, s := range tt.notExpected {
+					if strings.Contains(html, s) {
+						t.Errorf("expected HTML not to contain %q", s)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package dashboard
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// errorMessages are the keys of the title and message of the status codes
+// with a built-in error page
+var errorMessages = map[int][2]string{
+	http.StatusUnauthorized:        {shared.MESSAGE_ERROR_401_TITLE, shared.MESSAGE_ERROR_401_MESSAGE},
+	http.StatusForbidden:           {shared.MESSAGE_ERROR_403_TITLE, shared.MESSAGE_ERROR_403_MESSAGE},
+	http.StatusNotFound:            {shared.MESSAGE_ERROR_404_TITLE, shared.MESSAGE_ERROR_404_MESSAGE},
+	http.StatusInternalServerError: {shared.MESSAGE_ERROR_500_TITLE, shared.MESSAGE_ERROR_500_MESSAGE},
+	http.StatusServiceUnavailable:  {shared.MESSAGE_ERROR_503_TITLE, shared.MESSAGE_ERROR_503_MESSAGE},
+}
+
+// RenderErrorPage writes the error page with its status code, rendered with
+// the template of the dashboard. The page is shown inside the dashboard
+// shell, or as a standalone page with the PAGE_LAYOUT_ERROR page layout.
+// The title and content of the dashboard are replaced by the error.
+func RenderErrorPage(w http.ResponseWriter, d types.DashboardInterface, page types.ErrorPage) error {
+	page = errorPageWithDefaults(d, page)
+	return renderStatusPage(w, d, page.Status, page.Title, ErrorContent(d, page))
+}
+
+// RenderUnauthorized writes the 401 error page, linking to the login URL
+func RenderUnauthorized(w http.ResponseWriter, d types.DashboardInterface) error {
+	return RenderErrorPage(w, d, types.ErrorPage{Status: http.StatusUnauthorized})
+}
+
+// RenderForbidden writes the 403 error page
+func RenderForbidden(w http.ResponseWriter, d types.DashboardInterface) error {
+	return RenderErrorPage(w, d, types.ErrorPage{Status: http.StatusForbidden})
+}
+
+// RenderNotFound writes the 404 error page
+func RenderNotFound(w http.ResponseWriter, d types.DashboardInterface) error {
+	return RenderErrorPage(w, d, types.ErrorPage{Status: http.StatusNotFound})
+}
+
+// RenderInternalServerError writes the 500 error page, showing the request
+// ID when it is not empty
+func RenderInternalServerError(w http.ResponseWriter, d types.DashboardInterface, requestID string) error {
+	return RenderErrorPage(w, d, types.ErrorPage{Status: http.StatusInternalServerError, RequestID: requestID})
+}
+
+// RenderServiceUnavailable writes the 503 error page
+func RenderServiceUnavailable(w http.ResponseWriter, d types.DashboardInterface) error {
+	return RenderErrorPage(w, d, types.ErrorPage{Status: http.StatusServiceUnavailable})
+}
+
+// ErrorContent returns the content of the error page: the status code, the
+// message, the request ID and the links to the home page and, for the 401
+// page, to the login URL. The title is shown by the template.
+func ErrorContent(d types.DashboardInterface, page types.ErrorPage) *hb.Tag {
+	page = errorPageWithDefaults(d, page)
+
+	homeURL := lo.Ternary(d.GetLogoRedirectURL() != "", d.GetLogoRedirectURL(), "/")
+	showLogin := page.Status == http.StatusUnauthorized && d.GetLoginURL() != ""
+
+	links := hb.Div().Class("d-flex flex-wrap justify-content-center gap-2").
+		ChildIf(showLogin, hb.Hyperlink().Class("btn btn-primary mx-1").Href(d.GetLoginURL()).Text(d.Translate(shared.MESSAGE_SIGN_IN))).
+		Child(hb.Hyperlink().
+			Class(lo.Ternary(showLogin, "btn btn-outline-secondary mx-1", "btn btn-primary mx-1")).
+			Href(homeURL).
+			Text(d.Translate(shared.MESSAGE_BACK_TO_HOME)))
+
+	return hb.Div().Class("dashboard-error text-center py-4").
+		Child(hb.Div().Class("display-1 fw-bold font-weight-bold text-muted").Attr("aria-hidden", "true").Text(strconv.Itoa(page.Status))).
+		ChildIf(page.Message != "", hb.P().Class("lead mb-4").Text(page.Message)).
+		ChildIf(page.RequestID != "", hb.P().Class("small text-muted").
+			Text(d.Translate(shared.MESSAGE_REQUEST_ID)+": ").
+			Child(hb.Code().Text(page.RequestID))).
+		Child(links)
+}
+
+// renderStatusPage writes the page with the title and content, and the
+// status code, the page is not cached
+func renderStatusPage(w http.ResponseWriter, d types.DashboardInterface, status int, title string, content *hb.Tag) error {
+	d.SetTitle(title)
+	d.SetContentFragment(types.FragmentTag(content))
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	return d.Render(w)
+}
+
+// errorPageDashboard returns the dashboard of the request rendering an error
+// page, or a standalone error page dashboard when there is none
+func errorPageDashboard(newDashboard func(r *http.Request) types.DashboardInterface, r *http.Request) types.DashboardInterface {
+	if newDashboard != nil {
+		if d := newDashboard(r); d != nil {
+			return d
+		}
+	}
+
+	d := New()
+	d.SetHTTPRequest(r)
+	d.SetPageLayout(shared.PAGE_LAYOUT_ERROR)
+	return d
+}
+
+// errorPageWithDefaults returns the error page with the title and message
+// of its status code, translated, where they are not set
+func errorPageWithDefaults(d types.DashboardInterface, page types.ErrorPage) types.ErrorPage {
+	if page.Status == 0 {
+		page.Status = http.StatusInternalServerError
+	}
+
+	keys, found := errorMessages[page.Status]
+
+	if page.Title == "" {
+		page.Title = lo.Ternary(found, d.Translate(keys[0]), http.StatusText(page.Status))
+	}
+
+	if page.Message == "" && found {
+		page.Message = d.Translate(keys[1])
+	}
+
+	return page
+}
@@ -0,0 +1,195 @@
+package dashboard_test
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestErrorPages(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		render      func(w http.ResponseWriter, d types.DashboardInterface) error
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name:   "unauthorized",
+			status: http.StatusUnauthorized,
+			render: dashboard.RenderUnauthorized,
+			expected: []string{
+				`<title>Sign in required</title>`,
+				`You need to sign in to view this page.`,
+				`<a class="btn btn-primary mx-1" href="/login">Sign in</a>`,
+				`<a class="btn btn-outline-secondary mx-1" href="/">Back to home</a>`,
+			},
+		},
+		{
+			name:     "forbidden",
+			status:   http.StatusForbidden,
+			render:   dashboard.RenderForbidden,
+			expected: []string{`<title>Access denied</title>`, `>403</div>`},
+		},
+		{
+			name:        "not found",
+			status:      http.StatusNotFound,
+			render:      dashboard.RenderNotFound,
+			expected:    []string{`<title>Page not found</title>`, `<a class="btn btn-primary mx-1" href="/">Back to home</a>`},
+			notExpected: []string{`<a class="btn btn-primary mx-1" href="/login">`, `Request ID`},
+		},
+		{
+			name:   "internal server error",
+			status: http.StatusInternalServerError,
+			render: func(w http.ResponseWriter, d types.DashboardInterface) error {
+				return dashboard.RenderInternalServerError(w, d, "abc123")
+			},
+			expected: []string{`<title>Something went wrong</title>`, `Request ID: <code>abc123</code>`},
+		},
+		{
+			name:     "service unavailable",
+			status:   http.StatusServiceUnavailable,
+			render:   dashboard.RenderServiceUnavailable,
+			expected: []string{`<title>Service unavailable</title>`, `>503</div>`},
+		},
+		{
+			name:   "custom",
+			status: http.StatusTeapot,
+			render: func(w http.ResponseWriter, d types.DashboardInterface) error {
+				return dashboard.RenderErrorPage(w, d, types.ErrorPage{Status: http.StatusTeapot, Message: "No <coffee>"})
+			},
+			expected: []string{`<title>I&#39;m a teapot</title>`, `No &lt;coffee&gt;`},
+		},
+	}
+
+	for _, template := range []string{shared.TEMPLATE_BOOTSTRAP, shared.TEMPLATE_TABLER, shared.TEMPLATE_ADMINLTE} {
+		for _, pageLayout := range []string{shared.PAGE_LAYOUT_DASHBOARD, shared.PAGE_LAYOUT_ERROR} {
+			for _, tt := range tests {
+				t.Run(template+" "+pageLayout+" "+tt.name, func(t *testing.T) {
+					d := dashboard.New()
+					d.SetTemplate(template)
+					d.SetPageLayout(pageLayout)
+					d.SetLoginURL("/login")
+					d.SetContent(`<p id="replaced-content"></p>`)
+					d.SetMenuMainItems([]types.MenuItem{{Title: "Main menu item", URL: "/main"}})
+
+					recorder := httptest.NewRecorder()
+					if err := tt.render(recorder, d); err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+
+					if recorder.Code != tt.status {
+						t.Errorf("expected status %d, got %d", tt.status, recorder.Code)
+					}
+
+					if contentType := recorder.Header().Get("Content-Type"); contentType != "text/html; charset=utf-8" {
+						t.Errorf("expected an HTML response, got %q", contentType)
+					}
+
+					html := recorder.Body.String()
+
+					for _, s := range tt.expected {
+						if !strings.Contains(html, s) {
+							t.Errorf("expected HTML to contain %q", s)
+						}
+					}
+					for _, s := range tt.notExpected {
+						if strings.Contains(html, s) {
+							t.Errorf("expected HTML not to contain %q", s)
+						}
+					}
+
+					if strings.Contains(html, "replaced-content") {
+						t.Error("expected the content to be replaced by the error")
+					}
+
+					if inShell := strings.Contains(html, "Main menu item"); inShell != (pageLayout == shared.PAGE_LAYOUT_DASHBOARD) {
+						t.Errorf("expected the navigation only in the dashboard shell, found: %v", inShell)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestRecoverMiddleware(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	panicking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	t.Run("standalone", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		dashboard.RecoverMiddleware(nil)(panicking).ServeHTTP(recorder, httptest.NewRequest("GET", "/", nil))
+
+		if recorder.Code != http.StatusInternalServerError {
+			t.Errorf("expected status 500, got %d", recorder.Code)
+		}
+
+		requestID := recorder.Header().Get(shared.REQUEST_ID_HEADER)
+		if len(requestID) != 16 {
+			t.Fatalf("expected a generated request ID, got %q", requestID)
+		}
+
+		if !strings.Contains(recorder.Body.String(), `<code>`+requestID+`</code>`) {
+			t.Error("expected the request ID on the error page")
+		}
+	})
+
+	t.Run("request ID header and dashboard", func(t *testing.T) {
+		newDashboard := func(r *http.Request) types.DashboardInterface {
+			d := dashboard.New()
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+			d.SetMenuMainItems([]types.MenuItem{{Title: "Main menu item", URL: "/main"}})
+			return d
+		}
+
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set(shared.REQUEST_ID_HEADER, "proxy-id")
+
+		recorder := httptest.NewRecorder()
+		dashboard.RecoverMiddleware(newDashboard)(panicking).ServeHTTP(recorder, r)
+
+		if recorder.Header().Get(shared.REQUEST_ID_HEADER) != "proxy-id" {
+			t.Errorf("expected the request ID of the proxy, got %q", recorder.Header().Get(shared.REQUEST_ID_HEADER))
+		}
+
+		html := recorder.Body.String()
+		if !strings.Contains(html, `<code>proxy-id</code>`) || !strings.Contains(html, "Main menu item") || !strings.Contains(html, "tabler") {
+			t.Error("expected the error page in the shell of the dashboard")
+		}
+	})
+
+	t.Run("no panic", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		dashboard.RecoverMiddleware(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("ok"))
+		})).ServeHTTP(recorder, httptest.NewRequest("GET", "/", nil))
+
+		if recorder.Code != http.StatusOK || recorder.Body.String() != "ok" {
+			t.Errorf("expected the response of the handler, got %d %q", recorder.Code, recorder.Body.String())
+		}
+	})
+
+	t.Run("abort handler", func(t *testing.T) {
+		defer func() {
+			if recovered := recover(); recovered != http.ErrAbortHandler {
+				t.Errorf("expected http.ErrAbortHandler to be panicked again, got %v", recovered)
+			}
+		}()
+
+		dashboard.RecoverMiddleware(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	})
+}
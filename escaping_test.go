@@ -0,0 +1,112 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestEscaping(t *testing.T) {
+	const payload = `<script>alert(1)</script>`
+
+	tests := []struct {
+		template string
+		setup    func(d types.DashboardInterface)
+	}{
+		{template: shared.TEMPLATE_BOOTSTRAP},
+		{template: shared.TEMPLATE_BOOTSTRAP, setup: func(d types.DashboardInterface) {
+			d.SetMenuType(shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_SIDEBAR)
+		}},
+		{template: shared.TEMPLATE_TABLER},
+		{template: shared.TEMPLATE_TABLER, setup: func(d types.DashboardInterface) {
+			d.SetLayout(shared.TEMPLATE_TABLER_LAYOUT_HORIZONTAL)
+		}},
+		{template: shared.TEMPLATE_ADMINLTE},
+		{template: shared.TEMPLATE_ADMINLTE, setup: func(d types.DashboardInterface) {
+			d.SetAdminLTEConfig(types.AdminLTEConfig{LayoutTopNav: true})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(tt.template)
+			d.SetTitle(payload)
+			d.SetUser(types.User{FirstName: payload, LastName: payload, Email: payload})
+			d.SetMenuMainItems([]types.MenuItem{
+				{Title: payload, URL: "/"},
+				{Title: payload, Children: []types.MenuItem{{Title: payload, URL: "/child"}}},
+			})
+			d.SetMenuUserItems([]types.MenuItem{{Title: payload, URL: "/profile"}})
+			d.SetMenuQuickAccessItems([]types.MenuItem{{Title: payload, URL: "/new"}})
+			d.SetBreadcrumb([]types.BreadcrumbItem{{Title: payload, URL: "/"}, {Title: payload}})
+			d.SetActions([]types.Action{{Title: payload, OnClick: "save()"}})
+			d.AddAlert(types.Alert{Type: "info", Message: payload})
+			d.AddModal(types.Modal{ID: "TrustedModal", Title: payload, Content: `<b id="trusted-modal">Trusted</b>`})
+			d.SetContent(`<p id="trusted-content">Trusted</p>`)
+			d.SetFooter(types.Footer{Copyright: payload, HTML: `<i id="trusted-footer"></i>`})
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			html := d.ToHTML()
+
+			if strings.Contains(html, payload) {
+				t.Errorf("expected plain strings to be escaped, found %q", payload)
+			}
+
+			if !strings.Contains(html, "&lt;script&gt;alert(1)&lt;/script&gt;") {
+				t.Errorf("expected the escaped title to be rendered")
+			}
+
+			for _, trusted := range []string{`<p id="trusted-content">Trusted</p>`, `<i id="trusted-footer"></i>`} {
+				if !strings.Contains(html, trusted) {
+					t.Errorf("expected trusted HTML %q to be rendered as is", trusted)
+				}
+			}
+		})
+	}
+}
+
+func TestEscapingThemeNames(t *testing.T) {
+	const payload = `<script>alert(1)</script>`
+
+	templates := []string{shared.TEMPLATE_BOOTSTRAP, shared.TEMPLATE_TABLER, shared.TEMPLATE_ADMINLTE}
+
+	tests := []struct {
+		name  string
+		setup func(d types.DashboardInterface)
+	}{
+		{name: "restrict label", setup: func(d types.DashboardInterface) {
+			d.SetThemesRestrict(map[string]string{d.GetThemes()[0].ID: payload})
+		}},
+		{name: "brand theme name", setup: func(d types.DashboardInterface) {
+			d.AddBrandTheme(types.BrandTheme{ID: "acme", Name: payload, PrimaryColor: "#0055aa"})
+		}},
+	}
+
+	for _, template := range templates {
+		for _, tt := range tests {
+			t.Run(template+" "+tt.name, func(t *testing.T) {
+				d := dashboard.New()
+				d.SetTemplate(template)
+				d.SetUser(types.User{FirstName: "Jane"})
+				d.SetThemeHandlerUrl("/theme")
+				tt.setup(d)
+
+				html := d.ToHTML()
+
+				if strings.Contains(html, payload) {
+					t.Errorf("expected the theme name to be escaped, found %q", payload)
+				}
+
+				if !strings.Contains(html, "&lt;script&gt;alert(1)&lt;/script&gt;") {
+					t.Errorf("expected the escaped theme name to be rendered")
+				}
+			})
+		}
+	}
+}
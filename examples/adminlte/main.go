@@ -435,7 +435,7 @@ func handleHome(w http.ResponseWriter, r *http.Request) {
 	</div>
 	`
 
-	d.SetContent(content)
+	d.SetContent(types.HTML(content))
 
 	// Render the dashboard
 	html := d.ToHTML()
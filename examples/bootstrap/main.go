@@ -268,7 +268,7 @@ func handleHome(w http.ResponseWriter, r *http.Request) {
 
 	content := `Hello World`
 
-	d.SetContent(content)
+	d.SetContent(types.HTML(content))
 
 	// Get the HTML output and write it to the response
 	htmlOutput := d.ToHTML()
@@ -0,0 +1,92 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestFooterRendering(t *testing.T) {
+	footer := types.Footer{
+		Copyright:   "© 2025 Acme <Inc>",
+		Version:     "v1.2.3 (abc123)",
+		Environment: "staging",
+		HTML:        `<span id="custom-footer">Custom</span>`,
+		Columns: []types.FooterColumn{
+			{
+				Title: "Support",
+				Links: []types.MenuItem{
+					{Title: "Documentation", URL: "/docs"},
+				},
+			},
+		},
+	}
+
+	templates := []string{
+		shared.TEMPLATE_BOOTSTRAP,
+		shared.TEMPLATE_TABLER,
+		shared.TEMPLATE_ADMINLTE,
+	}
+
+	for _, template := range templates {
+		t.Run(template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(template)
+			d.SetUser(types.User{FirstName: "Jane"})
+			d.SetFooter(footer)
+			html := d.ToHTML()
+
+			expected := []string{
+				"<footer",
+				"© 2025 Acme &lt;Inc&gt;",
+				"v1.2.3 (abc123)",
+				"staging",
+				"Support",
+				`href="/docs"`,
+				`<span id="custom-footer">Custom</span>`,
+			}
+
+			for _, s := range expected {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+		})
+	}
+}
+
+func TestFooterOmittedWhenUnset(t *testing.T) {
+	templates := []string{
+		shared.TEMPLATE_BOOTSTRAP,
+		shared.TEMPLATE_TABLER,
+		shared.TEMPLATE_ADMINLTE,
+	}
+
+	for _, template := range templates {
+		t.Run(template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(template)
+			d.SetUser(types.User{FirstName: "Jane"})
+
+			if d.GetFooter() != nil {
+				t.Error("expected no footer by default")
+			}
+
+			d.SetFooter(types.Footer{})
+			if d.GetFooter() != nil {
+				t.Error("expected an empty footer to be treated as unset")
+			}
+
+			html := d.ToHTML()
+			if strings.Contains(html, "<footer") {
+				t.Error("expected no footer in the HTML")
+			}
+			if strings.Contains(html, "AdminLTE.io") {
+				t.Error("expected no hard-coded footer text")
+			}
+		})
+	}
+}
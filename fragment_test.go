@@ -0,0 +1,214 @@
+package dashboard_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	templatesshared "github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+func TestFragmentAdapters(t *testing.T) {
+	tests := []struct {
+		name     string
+		fragment types.Fragment
+		expected string
+	}{
+		{name: "html", fragment: types.FragmentHTML(`<b>Bold</b>`), expected: `<b>Bold</b>`},
+		{name: "template html", fragment: types.FragmentHTML(template.HTML(`<i>Italic</i>`)), expected: `<i>Italic</i>`},
+		{name: "tag", fragment: types.FragmentTag(hb.Span().Text("<Tag>")), expected: `<span>&lt;Tag&gt;</span>`},
+		{name: "nil tag", fragment: types.FragmentTag(nil), expected: ``},
+		{name: "func", fragment: types.FragmentFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, "written")
+			return err
+		}), expected: `written`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.fragment.Render(context.Background(), &buf); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestFragmentRendering(t *testing.T) {
+	tpl := template.Must(template.New("extra").Parse(`<span id="navbar-extra">{{.}}</span>`))
+
+	templates := []string{
+		shared.TEMPLATE_BOOTSTRAP,
+		shared.TEMPLATE_TABLER,
+		shared.TEMPLATE_ADMINLTE,
+	}
+
+	for _, name := range templates {
+		t.Run(name, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(name)
+			d.SetUser(types.User{FirstName: "Jane"})
+			d.SetContent(`<p id="ignored-content"></p>`)
+			d.SetContentFragment(types.FragmentTag(hb.Div().ID("tag-content").Text("Tag content")))
+			d.AddNavbarFragment(types.FragmentFunc(func(ctx context.Context, w io.Writer) error {
+				return tpl.Execute(w, "<Staging>")
+			}))
+			d.SetFooter(types.Footer{Fragment: types.FragmentHTML(`<i id="footer-fragment"></i>`)})
+			d.AddModal(types.Modal{
+				ID:             "FragmentModal",
+				Title:          "Modal",
+				Body:           types.FragmentTag(hb.P().ID("modal-body-fragment")),
+				FooterFragment: types.FragmentHTML(`<button id="modal-footer-fragment" type="button">OK</button>`),
+			})
+
+			html := d.ToHTML()
+
+			expected := []string{
+				`<div id="tag-content">Tag content</div>`,
+				`<span id="navbar-extra">&lt;Staging&gt;</span>`,
+				`<i id="footer-fragment"></i>`,
+			}
+			if name == shared.TEMPLATE_TABLER {
+				expected = append(expected, `<p id="modal-body-fragment"></p>`, `id="modal-footer-fragment"`)
+			}
+
+			for _, s := range expected {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+
+			if strings.Contains(html, "ignored-content") {
+				t.Error("expected the content fragment to take precedence over the content")
+			}
+
+			if strings.Contains(html, templatesshared.FRAGMENT_PLACEHOLDER_PREFIX) {
+				t.Error("expected all the placeholders to be replaced")
+			}
+		})
+	}
+}
+
+func TestRenderStreamsFragments(t *testing.T) {
+	var out bytes.Buffer
+	streamed := false
+
+	d := dashboard.New()
+	d.SetContentFragment(types.FragmentFunc(func(ctx context.Context, w io.Writer) error {
+		streamed = w == io.Writer(&out)
+		_, err := io.WriteString(w, "<p>Streamed</p>")
+		return err
+	}))
+
+	if err := d.Render(&out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !streamed {
+		t.Error("expected the fragment to be written straight to the output")
+	}
+
+	if !strings.Contains(out.String(), "<p>Streamed</p>") || !strings.HasSuffix(strings.TrimSpace(out.String()), "</html>") {
+		t.Error("expected the whole page to be rendered around the fragment")
+	}
+}
+
+func TestRenderFragmentError(t *testing.T) {
+	failure := errors.New("template failed")
+
+	d := dashboard.New()
+	d.SetContentFragment(types.FragmentFunc(func(ctx context.Context, w io.Writer) error {
+		return failure
+	}))
+
+	if err := d.Render(io.Discard); !errors.Is(err, failure) {
+		t.Errorf("expected the fragment error, got %v", err)
+	}
+}
+
+func TestRenderPlaceholderInMarkup(t *testing.T) {
+	templates := []string{
+		shared.TEMPLATE_BOOTSTRAP,
+		shared.TEMPLATE_ADMINLTE,
+	}
+
+	for _, name := range templates {
+		t.Run(name, func(t *testing.T) {
+			logo := `<!--dashboard-fragment:content--><b>Logo</b>`
+
+			d := dashboard.New()
+			d.SetTemplate(name)
+			d.SetUser(types.User{FirstName: "Jane"})
+			d.SetLogoRawHtml(types.HTML(logo))
+			d.SetContent(`<p id="content-once">Content</p>`)
+
+			html := d.ToHTML()
+
+			if !strings.Contains(html, logo) {
+				t.Error("expected the logo markup to be left as it is")
+			}
+
+			if count := strings.Count(html, `id="content-once"`); count != 1 {
+				t.Errorf("expected the content to be rendered once, got %d", count)
+			}
+
+			if d.GetFragmentToken() == "" || strings.Contains(html, d.GetFragmentToken()) {
+				t.Error("expected the placeholders of the render to be replaced")
+			}
+		})
+	}
+}
+
+func TestToHTMLFragmentError(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	d := dashboard.New()
+	d.SetContentFragment(types.FragmentFunc(func(ctx context.Context, w io.Writer) error {
+		return errors.New("template failed")
+	}))
+
+	html := d.ToHTML()
+
+	if !strings.Contains(logs.String(), "template failed") {
+		t.Errorf("expected the fragment error to be logged, got %q", logs.String())
+	}
+
+	if strings.Contains(html, "</html>") {
+		t.Error("expected the page to stop at the failing fragment")
+	}
+}
+
+func TestFragmentFuncContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "request-42")
+
+	fragment := types.FragmentFunc(func(ctx context.Context, w io.Writer) error {
+		value, _ := ctx.Value(key{}).(string)
+		_, err := io.WriteString(w, value)
+		return err
+	})
+
+	var buf bytes.Buffer
+	if err := fragment.Render(ctx, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if buf.String() != "request-42" {
+		t.Errorf("expected the function to receive the context, got %q", buf.String())
+	}
+}
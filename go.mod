@@ -7,11 +7,11 @@ require (
 	github.com/dracory/hb v1.88.0
 	github.com/dracory/req v0.1.0
 	github.com/samber/lo v1.52.0
+	golang.org/x/net v0.46.0
 )
 
 require (
 	github.com/rogpeppe/go-internal v1.14.1 // indirect
 	github.com/spf13/cast v1.10.0 // indirect
-	golang.org/x/net v0.46.0 // indirect
 	golang.org/x/text v0.30.0 // indirect
 )
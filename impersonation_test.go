@@ -0,0 +1,100 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestImpersonation(t *testing.T) {
+	d := dashboard.New()
+
+	if d.GetImpersonation() != nil {
+		t.Fatal("expected no impersonation by default")
+	}
+
+	d.SetImpersonation(types.Impersonation{RealUser: types.User{DisplayName: "Sam Support"}, StopURL: "/impersonation/stop"})
+
+	if impersonation := d.GetImpersonation(); impersonation == nil || impersonation.RealUser.Name() != "Sam Support" {
+		t.Fatalf("expected the impersonation to be set, got %+v", impersonation)
+	}
+
+	d.ClearImpersonation()
+
+	if d.GetImpersonation() != nil {
+		t.Error("expected the impersonation to be cleared")
+	}
+}
+
+func TestImpersonationRendering(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(d types.DashboardInterface)
+	}{
+		{name: "bootstrap", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+		}},
+		{name: "tabler", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+		}},
+		{name: "adminlte", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_ADMINLTE)
+		}},
+		{name: "adminlte fixed", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_ADMINLTE)
+			d.SetAdminLTEConfig(types.AdminLTEConfig{FixedHeader: true, FixedSidebar: true})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetUser(types.User{FirstName: "Carol", LastName: "Customer"})
+			d.SetMenuUserItems([]types.MenuItem{{Title: "Profile", URL: "/profile"}})
+			d.SetContent(`<p id="content"></p>`)
+			tt.setup(d)
+
+			if html := d.ToHTML(); strings.Contains(html, "dashboard-impersonation") {
+				t.Fatal("expected no impersonation banner when not impersonating")
+			}
+
+			d.SetImpersonation(types.Impersonation{
+				RealUser:  types.User{DisplayName: "Sam <Support>"},
+				StopURL:   "/impersonation/stop",
+				CSRFToken: "token",
+			})
+
+			html := d.ToHTML()
+
+			expected := []string{
+				`aria-label="Impersonating" class="dashboard-impersonation alert alert-warning`,
+				`<strong>Carol Customer</strong>`,
+				`Signed in as Sam &lt;Support&gt;`,
+				`action="/impersonation/stop"`,
+				`name="csrf_token" type="hidden" value="token"`,
+				`class="btn btn-sm btn-dark mx-2" type="submit">Stop impersonating</button>`,
+				`class="dropdown-item" type="submit">Stop impersonating</button>`,
+			}
+			for _, s := range expected {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+
+			if strings.Contains(html, "Sam <Support>") {
+				t.Error("expected the name of the real user to be escaped")
+			}
+
+			if count := strings.Count(html, `class="dashboard-impersonation `); count != 1 {
+				t.Errorf("expected one banner, got %d", count)
+			}
+
+			if strings.Index(html, "dashboard-impersonation ") > strings.Index(html, `id="content"`) {
+				t.Error("expected the banner above the content")
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package dashboard
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dracory/dashboard/shared"
+)
+
+// LocaleMiddleware returns a middleware which negotiates the locale from the
+// Accept-Language header against the supported locales, and stores it in the
+// request context, where SetHTTPRequest picks it up. The first supported
+// locale is used when none of the accepted languages is supported
+func LocaleMiddleware(supportedLocales ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			locale := LocaleFromAcceptLanguage(r.Header.Get("Accept-Language"), supportedLocales)
+
+			if locale != "" {
+				ctx := context.WithValue(r.Context(), shared.LocaleContextKey{}, locale)
+				r = r.WithContext(ctx)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// LocaleFromAcceptLanguage returns the supported locale best matching the
+// Accept-Language header, e.g. "de-AT,de;q=0.9,en;q=0.8". A language also
+// matches the regional locales and vice versa, e.g. "de-AT" matches "de".
+// The first supported locale is returned when nothing matches
+func LocaleFromAcceptLanguage(acceptLanguage string, supportedLocales []string) string {
+	if len(supportedLocales) == 0 {
+		return ""
+	}
+
+	for _, accepted := range acceptLanguageParse(acceptLanguage) {
+		if accepted == "*" {
+			return supportedLocales[0]
+		}
+
+		// Exact match first, then a match on the language
+		for _, supported := range supportedLocales {
+			if strings.EqualFold(supported, accepted) {
+				return supported
+			}
+		}
+
+		language, _, _ := strings.Cut(accepted, "-")
+		for _, supported := range supportedLocales {
+			supportedLanguage, _, _ := strings.Cut(strings.ReplaceAll(supported, "_", "-"), "-")
+			if strings.EqualFold(supportedLanguage, language) {
+				return supported
+			}
+		}
+	}
+
+	return supportedLocales[0]
+}
+
+// acceptLanguageParse returns the languages of the Accept-Language header,
+// ordered by their quality, languages with a zero quality are left out
+func acceptLanguageParse(acceptLanguage string) []string {
+	type weighted struct {
+		language string
+		quality  float64
+	}
+
+	languages := []weighted{}
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		language, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		language = strings.TrimSpace(language)
+		if language == "" {
+			continue
+		}
+
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				quality = parsed
+			}
+		}
+
+		if quality <= 0 {
+			continue
+		}
+
+		languages = append(languages, weighted{language: language, quality: quality})
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+
+	result := make([]string, 0, len(languages))
+	for _, language := range languages {
+		result = append(result, language.language)
+	}
+
+	return result
+}
@@ -0,0 +1,161 @@
+package dashboard_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestLocaleFromAcceptLanguage(t *testing.T) {
+	supported := []string{"en", "de", "pt-BR"}
+
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "empty header", header: "", expected: "en"},
+		{name: "exact match", header: "de", expected: "de"},
+		{name: "case insensitive", header: "PT-br", expected: "pt-BR"},
+		{name: "language match", header: "de-AT", expected: "de"},
+		{name: "quality order", header: "fr;q=0.9, de;q=0.5, en;q=0.1", expected: "de"},
+		{name: "wildcard", header: "fr, *;q=0.5", expected: "en"},
+		{name: "no match", header: "fr, it", expected: "en"},
+		{name: "zero quality ignored", header: "de;q=0, pt-BR;q=0.2", expected: "pt-BR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locale := dashboard.LocaleFromAcceptLanguage(tt.header, supported)
+			if locale != tt.expected {
+				t.Errorf("expected locale %q, got %q", tt.expected, locale)
+			}
+		})
+	}
+}
+
+func TestLocaleMiddleware(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept-Language", "de-DE,de;q=0.9,en;q=0.8")
+
+	handlerCalled := false
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+
+		locale, ok := r.Context().Value(shared.LocaleContextKey{}).(string)
+		if !ok || locale != "de" {
+			t.Fatalf("expected locale de in the context, got %q", locale)
+		}
+
+		d := dashboard.New()
+		d.SetHTTPRequest(r)
+		if d.GetLocale() != "de" {
+			t.Errorf("expected dashboard locale de, got %q", d.GetLocale())
+		}
+	})
+
+	dashboard.LocaleMiddleware("en", "de")(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	if !handlerCalled {
+		t.Fatal("expected the handler to be called")
+	}
+}
+
+func TestMessageCatalog(t *testing.T) {
+	catalog := dashboard.MessageCatalog{
+		"de": {
+			shared.MESSAGE_MENU: "Menü",
+		},
+		"de-AT": {
+			shared.MESSAGE_CLOSE: "Zumachen",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		locale   string
+		key      string
+		expected string
+	}{
+		{name: "exact locale", locale: "de-AT", key: shared.MESSAGE_CLOSE, expected: "Zumachen"},
+		{name: "language fallback", locale: "de-AT", key: shared.MESSAGE_MENU, expected: "Menü"},
+		{name: "missing message", locale: "de", key: shared.MESSAGE_CLOSE, expected: ""},
+		{name: "missing locale", locale: "fr", key: shared.MESSAGE_MENU, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if translation := catalog.Translate(tt.locale, tt.key); translation != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, translation)
+			}
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	d := dashboard.New()
+	if d.Translate(shared.MESSAGE_MENU) != "Menu" {
+		t.Errorf("expected English default, got %q", d.Translate(shared.MESSAGE_MENU))
+	}
+
+	d.SetLocale("de")
+	d.SetTranslator(dashboard.MessageCatalog{"de": {shared.MESSAGE_MENU: "Menü"}})
+
+	if d.Translate(shared.MESSAGE_MENU) != "Menü" {
+		t.Errorf("expected translation, got %q", d.Translate(shared.MESSAGE_MENU))
+	}
+	if d.Translate(shared.MESSAGE_CLOSE) != "Close" {
+		t.Errorf("expected English fallback, got %q", d.Translate(shared.MESSAGE_CLOSE))
+	}
+}
+
+func TestLocalizedRendering(t *testing.T) {
+	catalog := dashboard.MessageCatalog{
+		"de": {
+			shared.MESSAGE_HOME:    "Startseite",
+			shared.MESSAGE_LOGIN:   "Anmelden",
+			shared.MESSAGE_SIGN_IN: "Anmelden",
+		},
+	}
+
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{template: shared.TEMPLATE_BOOTSTRAP, expected: "Anmelden"},
+		{template: shared.TEMPLATE_TABLER, expected: "Anmelden"},
+		{template: shared.TEMPLATE_ADMINLTE, expected: "Startseite"},
+	}
+
+	for _, tt := range tests {
+		template := tt.template
+		t.Run(template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(template)
+			d.SetLocale("de")
+			d.SetTranslator(catalog)
+			d.SetLoginURL("/login")
+			html := d.ToHTML()
+
+			if !strings.Contains(html, `lang="de"`) {
+				t.Error(`expected the html element to have lang="de"`)
+			}
+			if !strings.Contains(html, tt.expected) {
+				t.Errorf("expected the translated text %q", tt.expected)
+			}
+		})
+	}
+
+	// Without a locale the page stays in English
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+	d.SetUser(types.User{FirstName: "Jane"})
+	if html := d.ToHTML(); !strings.Contains(html, `lang="en"`) {
+		t.Error(`expected the html element to have lang="en"`)
+	}
+}
@@ -0,0 +1,160 @@
+package dashboard
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// maintenanceRetryAfterDefault is the Retry-After of the maintenance page
+// when neither the ETA nor the retry after duration are set
+const maintenanceRetryAfterDefault = 5 * time.Minute
+
+// MaintenanceMiddleware returns a middleware which serves the maintenance
+// page, with the 503 status code and the Retry-After header, while the
+// maintenance mode is on: the Enabled function returns true or the file
+// exists. The allowed paths, IP addresses and roles are served as usual.
+func MaintenanceMiddleware(config types.MaintenanceConfig) func(http.Handler) http.Handler {
+	allowedNetworks := parseNetworks(config.AllowedIPs)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !MaintenanceEnabled(config) || maintenanceAllowed(config, allowedNetworks, r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			_ = RenderMaintenancePage(w, errorPageDashboard(config.Dashboard, r), config)
+		})
+	}
+}
+
+// MaintenanceEnabled returns whether the maintenance mode of the
+// configuration is on
+func MaintenanceEnabled(config types.MaintenanceConfig) bool {
+	if config.Enabled != nil && config.Enabled() {
+		return true
+	}
+
+	if config.File != "" {
+		if _, err := os.Stat(config.File); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RenderMaintenancePage writes the maintenance page, with the 503 status
+// code and the Retry-After header, rendered with the template of the
+// dashboard
+func RenderMaintenancePage(w http.ResponseWriter, d types.DashboardInterface, config types.MaintenanceConfig) error {
+	w.Header().Set("Retry-After", strconv.Itoa(maintenanceRetryAfter(config, time.Now())))
+
+	return renderStatusPage(w, d, http.StatusServiceUnavailable, d.Translate(shared.MESSAGE_MAINTENANCE_TITLE), MaintenanceContent(d, config))
+}
+
+// MaintenanceContent returns the content of the maintenance page: the
+// message and the expected end of the maintenance. The title is shown by
+// the template.
+func MaintenanceContent(d types.DashboardInterface, config types.MaintenanceConfig) *hb.Tag {
+	message := lo.Ternary(config.Message != "", config.Message, d.Translate(shared.MESSAGE_MAINTENANCE_MESSAGE))
+
+	content := hb.Div().Class("dashboard-maintenance text-center py-4").
+		Child(hb.P().Class("lead mb-3").Text(message))
+
+	if !config.ETA.IsZero() {
+		content.Child(hb.P().Class("text-muted").
+			Text(d.Translate(shared.MESSAGE_MAINTENANCE_ETA) + " ").
+			Child(hb.NewTag("time").
+				Attr("datetime", config.ETA.Format(time.RFC3339)).
+				Text(config.ETA.Format("2006-01-02 15:04 MST"))))
+	}
+
+	return content
+}
+
+// maintenanceRetryAfter returns the Retry-After seconds, until the ETA when
+// it is in the future
+func maintenanceRetryAfter(config types.MaintenanceConfig, now time.Time) int {
+	retryAfter := lo.Ternary(config.RetryAfter > 0, config.RetryAfter, maintenanceRetryAfterDefault)
+
+	if !config.ETA.IsZero() && config.ETA.After(now) {
+		retryAfter = config.ETA.Sub(now)
+	}
+
+	return int(math.Ceil(retryAfter.Seconds()))
+}
+
+// maintenanceAllowed returns whether the request is served during the
+// maintenance, for the allowed paths, IP addresses and roles
+func maintenanceAllowed(config types.MaintenanceConfig, allowedNetworks []*net.IPNet, r *http.Request) bool {
+	for _, path := range config.AllowedPaths {
+		if path != "" && strings.HasPrefix(r.URL.Path, path) {
+			return true
+		}
+	}
+
+	if len(allowedNetworks) > 0 {
+		// The headers set by proxies, e.g. X-Forwarded-For, can be spoofed
+		// by the client, they are only trusted through ClientIP
+		clientIP := lo.TernaryF(config.ClientIP != nil, func() string { return config.ClientIP(r) }, func() string { return r.RemoteAddr })
+		if ip := net.ParseIP(stripPort(clientIP)); ip != nil {
+			for _, network := range allowedNetworks {
+				if network.Contains(ip) {
+					return true
+				}
+			}
+		}
+	}
+
+	if len(config.AllowedRoles) > 0 && config.UserRoles != nil {
+		for _, role := range config.UserRoles(r) {
+			if lo.Contains(config.AllowedRoles, role) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// parseNetworks parses the IP addresses and CIDR ranges, invalid entries
+// are skipped
+func parseNetworks(entries []string) []*net.IPNet {
+	networks := []*net.IPNet{}
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			networks = append(networks, network)
+			continue
+		}
+
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := lo.Ternary(ip.To4() != nil, 32, 128)
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+		}
+	}
+
+	return networks
+}
+
+// stripPort returns the host of an address which may have a port, e.g. the
+// RemoteAddr of a request
+func stripPort(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
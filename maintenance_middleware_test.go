@@ -0,0 +1,190 @@
+package dashboard_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestMaintenanceMiddleware(t *testing.T) {
+	on := func() bool { return true }
+	off := func() bool { return false }
+
+	tests := []struct {
+		name       string
+		config     types.MaintenanceConfig
+		path       string
+		remoteAddr string
+		header     map[string]string
+		served     bool
+	}{
+		{name: "off", config: types.MaintenanceConfig{Enabled: off}, served: true},
+		{name: "no flag nor file", config: types.MaintenanceConfig{}, served: true},
+		{name: "missing file", config: types.MaintenanceConfig{File: filepath.Join(t.TempDir(), "missing")}, served: true},
+		{name: "on", config: types.MaintenanceConfig{Enabled: on}, served: false},
+		{name: "allowed path", config: types.MaintenanceConfig{Enabled: on, AllowedPaths: []string{"/static/", "/theme"}}, path: "/static/app.css", served: true},
+		{name: "theme handler", config: types.MaintenanceConfig{Enabled: on, AllowedPaths: []string{"/static/", "/theme"}}, path: "/theme?theme=dark", served: true},
+		{name: "other path", config: types.MaintenanceConfig{Enabled: on, AllowedPaths: []string{"/static/"}}, path: "/users", served: false},
+		{name: "allowed ip", config: types.MaintenanceConfig{Enabled: on, AllowedIPs: []string{"192.0.2.10"}}, remoteAddr: "192.0.2.10:4321", served: true},
+		{name: "allowed range", config: types.MaintenanceConfig{Enabled: on, AllowedIPs: []string{"10.0.0.0/8"}}, remoteAddr: "10.1.2.3:4321", served: true},
+		{name: "allowed ipv6", config: types.MaintenanceConfig{Enabled: on, AllowedIPs: []string{"2001:db8::/32"}}, remoteAddr: "[2001:db8::1]:4321", served: true},
+		{name: "other ip", config: types.MaintenanceConfig{Enabled: on, AllowedIPs: []string{"10.0.0.0/8", "invalid"}}, remoteAddr: "192.0.2.10:4321", served: false},
+		{name: "spoofed real ip", config: types.MaintenanceConfig{Enabled: on, AllowedIPs: []string{"203.0.113.5"}}, header: map[string]string{"X-Real-IP": "203.0.113.5"}, served: false},
+		{name: "spoofed forwarded for", config: types.MaintenanceConfig{Enabled: on, AllowedIPs: []string{"203.0.113.5"}}, header: map[string]string{"X-Forwarded-For": "203.0.113.5"}, served: false},
+		{name: "client ip function", config: types.MaintenanceConfig{
+			Enabled:    on,
+			AllowedIPs: []string{"203.0.113.5"},
+			ClientIP:   func(r *http.Request) string { return r.Header.Get("X-Real-IP") },
+		}, header: map[string]string{"X-Real-IP": "203.0.113.5"}, served: true},
+		{name: "allowed role", config: types.MaintenanceConfig{
+			Enabled:      on,
+			AllowedRoles: []string{"admin"},
+			UserRoles:    func(r *http.Request) []string { return []string{"editor", "admin"} },
+		}, served: true},
+		{name: "other role", config: types.MaintenanceConfig{
+			Enabled:      on,
+			AllowedRoles: []string{"admin"},
+			UserRoles:    func(r *http.Request) []string { return []string{"editor"} },
+		}, served: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.path
+			if path == "" {
+				path = "/"
+			}
+			r := httptest.NewRequest("GET", path, nil)
+			if tt.remoteAddr != "" {
+				r.RemoteAddr = tt.remoteAddr
+			}
+			for key, value := range tt.header {
+				r.Header.Set(key, value)
+			}
+
+			recorder := httptest.NewRecorder()
+			dashboard.MaintenanceMiddleware(tt.config)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("served"))
+			})).ServeHTTP(recorder, r)
+
+			if served := recorder.Body.String() == "served"; served != tt.served {
+				t.Fatalf("expected served %v, got status %d", tt.served, recorder.Code)
+			}
+
+			if !tt.served && recorder.Code != http.StatusServiceUnavailable {
+				t.Errorf("expected status 503, got %d", recorder.Code)
+			}
+		})
+	}
+}
+
+func TestMaintenanceFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "maintenance")
+	config := types.MaintenanceConfig{File: file}
+
+	if dashboard.MaintenanceEnabled(config) {
+		t.Error("expected the maintenance mode to be off without the file")
+	}
+
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if !dashboard.MaintenanceEnabled(config) {
+		t.Error("expected the maintenance mode to be on with the file")
+	}
+}
+
+func TestMaintenancePage(t *testing.T) {
+	eta := time.Now().Add(90 * time.Minute).UTC()
+
+	tests := []struct {
+		name       string
+		config     types.MaintenanceConfig
+		retryAfter func(seconds int) bool
+		expected   []string
+	}{
+		{
+			name:       "defaults",
+			config:     types.MaintenanceConfig{},
+			retryAfter: func(seconds int) bool { return seconds == 300 },
+			expected: []string{
+				`<title>Down for maintenance</title>`,
+				`We are performing scheduled maintenance. Please check back soon.`,
+			},
+		},
+		{
+			name:       "retry after",
+			config:     types.MaintenanceConfig{RetryAfter: 30 * time.Second, Message: "Upgrading <db>"},
+			retryAfter: func(seconds int) bool { return seconds == 30 },
+			expected:   []string{`Upgrading &lt;db&gt;`},
+		},
+		{
+			name:       "eta",
+			config:     types.MaintenanceConfig{ETA: eta},
+			retryAfter: func(seconds int) bool { return seconds > 5300 && seconds <= 5400 },
+			expected: []string{
+				`Expected back by <time datetime="` + eta.Format(time.RFC3339) + `">` + eta.Format("2006-01-02 15:04 MST") + `</time>`,
+			},
+		},
+		{
+			name:       "past eta",
+			config:     types.MaintenanceConfig{ETA: time.Now().Add(-time.Hour)},
+			retryAfter: func(seconds int) bool { return seconds == 300 },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+			d.SetMenuMainItems([]types.MenuItem{{Title: "Main menu item", URL: "/main"}})
+
+			recorder := httptest.NewRecorder()
+			if err := dashboard.RenderMaintenancePage(recorder, d, tt.config); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if recorder.Code != http.StatusServiceUnavailable {
+				t.Errorf("expected status 503, got %d", recorder.Code)
+			}
+
+			seconds, err := strconv.Atoi(recorder.Header().Get("Retry-After"))
+			if err != nil || !tt.retryAfter(seconds) {
+				t.Errorf("unexpected Retry-After %q", recorder.Header().Get("Retry-After"))
+			}
+
+			html := recorder.Body.String()
+			for _, s := range tt.expected {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+
+			if !strings.Contains(html, "Main menu item") {
+				t.Error("expected the maintenance page in the shell of the dashboard")
+			}
+		})
+	}
+}
+
+func TestMaintenanceMiddlewareStandalone(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	dashboard.MaintenanceMiddleware(types.MaintenanceConfig{
+		Enabled: func() bool { return true },
+	})(http.NotFoundHandler()).ServeHTTP(recorder, httptest.NewRequest("GET", "/", nil))
+
+	html := recorder.Body.String()
+	if !strings.Contains(html, `<main class="d-flex align-items-center justify-content-center`) || !strings.Contains(html, "Down for maintenance") {
+		t.Error("expected the standalone maintenance page")
+	}
+}
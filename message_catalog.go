@@ -0,0 +1,51 @@
+package dashboard
+
+import (
+	"strings"
+
+	"github.com/dracory/dashboard/types"
+)
+
+// MessageCatalog is a translator holding the messages per locale, e.g.
+//
+//	dashboard.MessageCatalog{
+//		"de": {dashboard.MESSAGE_MENU: "Menü", dashboard.MESSAGE_CLOSE: "Schließen"},
+//	}
+//
+// Regional locales fall back to their language, e.g. "de-AT" uses "de"
+type MessageCatalog map[string]map[string]string
+
+// Ensure MessageCatalog implements the TranslatorInterface
+var _ types.TranslatorInterface = MessageCatalog(nil)
+
+// Translate returns the message for the key in the locale, or an empty
+// string when the catalog has no such message
+func (c MessageCatalog) Translate(locale, key string) string {
+	for _, candidate := range localeCandidates(locale) {
+		for catalogLocale, messages := range c {
+			if !strings.EqualFold(catalogLocale, candidate) {
+				continue
+			}
+			if message, ok := messages[key]; ok {
+				return message
+			}
+		}
+	}
+
+	return ""
+}
+
+// localeCandidates returns the locale followed by its language,
+// e.g. "de-AT" returns "de-AT" and "de"
+func localeCandidates(locale string) []string {
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+	if locale == "" {
+		return []string{}
+	}
+
+	if language, _, found := strings.Cut(locale, "-"); found {
+		return []string{locale, language}
+	}
+
+	return []string{locale}
+}
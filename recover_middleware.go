@@ -0,0 +1,60 @@
+package dashboard
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// RecoverMiddleware returns a middleware which recovers the panics of the
+// handlers, logs them with their stack trace and writes the 500 error page
+// with the ID of the request, so the error can be found in the logs.
+//
+// The request ID is read from the X-Request-ID header when set by a proxy,
+// or generated, and written to the response. The page is rendered with the
+// dashboard returned by newDashboard, e.g. created with the template, logo
+// and menus of the application, or as a standalone error page when
+// newDashboard is nil.
+func RecoverMiddleware(newDashboard func(r *http.Request) types.DashboardInterface) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				recovered := recover()
+				if recovered == nil {
+					return
+				}
+
+				// The server aborts the response silently, keep it that way
+				if recovered == http.ErrAbortHandler {
+					panic(recovered)
+				}
+
+				requestID := r.Header.Get(shared.REQUEST_ID_HEADER)
+				if requestID == "" {
+					requestID = newRequestID()
+				}
+
+				log.Printf("dashboard: panic serving %s %s, request ID %s: %v\n%s", r.Method, r.URL.Path, requestID, recovered, debug.Stack())
+
+				w.Header().Set(shared.REQUEST_ID_HEADER, requestID)
+				if err := RenderInternalServerError(w, errorPageDashboard(newDashboard, r), requestID); err != nil {
+					log.Println("dashboard: error rendering the error page:", err)
+				}
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// newRequestID returns a random request ID of 16 hexadecimal characters
+func newRequestID() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
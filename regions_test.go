@@ -0,0 +1,136 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestRegionFragments(t *testing.T) {
+	d := dashboard.New()
+
+	if len(d.GetRegionFragments(shared.REGION_NAVBAR_END)) != 0 {
+		t.Fatal("expected an empty region")
+	}
+
+	d.AddRegionFragments(shared.REGION_NAVBAR_END, types.FragmentHTML("a"), types.FragmentHTML("b"))
+	d.AddNavbarFragment(types.FragmentHTML("c"))
+
+	if got := len(d.GetRegionFragments(shared.REGION_NAVBAR_END)); got != 3 {
+		t.Errorf("expected 3 fragments, got %d", got)
+	}
+
+	if got := len(d.GetNavbarFragments()); got != 3 {
+		t.Errorf("expected the navbar fragments to be the navbar-end region, got %d", got)
+	}
+
+	d.ClearRegion(shared.REGION_NAVBAR_END)
+
+	if got := len(d.GetRegionFragments(shared.REGION_NAVBAR_END)); got != 0 {
+		t.Errorf("expected the region to be cleared, got %d fragments", got)
+	}
+}
+
+func TestRegionRendering(t *testing.T) {
+	regions := []string{
+		shared.REGION_NAVBAR_START,
+		shared.REGION_NAVBAR_END,
+		shared.REGION_SIDEBAR_TOP,
+		shared.REGION_SIDEBAR_BOTTOM,
+		shared.REGION_PAGE_HEADER_END,
+		shared.REGION_CONTENT_BEFORE,
+		shared.REGION_CONTENT_AFTER,
+		shared.REGION_BODY_END,
+	}
+
+	tests := []struct {
+		name      string
+		noSidebar bool
+		setup     func(d types.DashboardInterface)
+	}{
+		{name: "bootstrap offcanvas", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+		}},
+		{name: "bootstrap modal", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+			d.SetMenuType(shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_MODAL)
+		}},
+		{name: "bootstrap sidebar", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+			d.SetMenuType(shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_SIDEBAR)
+		}},
+		{name: "tabler vertical", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+			d.SetLayout(shared.TEMPLATE_TABLER_LAYOUT_VERTICAL)
+		}},
+		{name: "tabler horizontal", noSidebar: true, setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+			d.SetLayout(shared.TEMPLATE_TABLER_LAYOUT_HORIZONTAL)
+		}},
+		{name: "tabler condensed", noSidebar: true, setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+			d.SetLayout(shared.TEMPLATE_TABLER_LAYOUT_CONDENSED)
+		}},
+		{name: "adminlte", setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_ADMINLTE)
+		}},
+		{name: "adminlte top navigation", noSidebar: true, setup: func(d types.DashboardInterface) {
+			d.SetTemplate(shared.TEMPLATE_ADMINLTE)
+			d.SetAdminLTEConfig(types.AdminLTEConfig{LayoutTopNav: true})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTitle("Regions")
+			d.SetUser(types.User{FirstName: "Jane"})
+			d.SetContent(`<p id="content"></p>`)
+			tt.setup(d)
+
+			for _, region := range regions {
+				d.AddRegionFragments(region,
+					types.FragmentHTML(types.HTML(`<i id="`+region+`-1"></i>`)),
+					types.FragmentHTML(types.HTML(`<i id="`+region+`-2"></i>`)))
+			}
+
+			html := d.ToHTML()
+
+			for _, region := range regions {
+				if tt.noSidebar && (region == shared.REGION_SIDEBAR_TOP || region == shared.REGION_SIDEBAR_BOTTOM) {
+					continue
+				}
+
+				first := strings.Index(html, `id="`+region+`-1"`)
+				second := strings.Index(html, `id="`+region+`-2"`)
+
+				if first < 0 || second < 0 {
+					t.Errorf("expected the %s region to be rendered", region)
+					continue
+				}
+
+				if strings.Count(html, `id="`+region+`-1"`) != 1 {
+					t.Errorf("expected the %s region to be rendered once", region)
+				}
+
+				if first > second {
+					t.Errorf("expected the fragments of the %s region to keep their order", region)
+				}
+			}
+
+			before := strings.Index(html, `id="content-before-1"`)
+			content := strings.Index(html, `id="content"`)
+			after := strings.Index(html, `id="content-after-1"`)
+			if !(before < content && content < after) {
+				t.Error("expected the content to be between the content before and after regions")
+			}
+
+			if strings.Index(html, `id="body-end-1"`) < strings.Index(html, `id="content-after-2"`) {
+				t.Error("expected the body end region after the layout")
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package dashboard
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
+
+	templatesshared "github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// Render writes the HTML of the dashboard to w. The template renders the
+// page with placeholders, the fragments (content, modal bodies and
+// footers, footer, layout regions, widgets) are written straight into w in
+// place of their placeholders, with the context of the HTTP request if one
+// is set. The placeholders hold a random token generated for the render,
+// only they are replaced, not the markup of the application looking like
+// a placeholder.
+func (d *dashboard) Render(w io.Writer) error {
+	templateName, template := d.findTemplate()
+
+	if template == nil {
+		_, err := io.WriteString(w, "Template not found: "+templateName)
+		return err
+	}
+
+	ctx := d.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	token, err := fragmentToken()
+	if err != nil {
+		return err
+	}
+	d.fragmentToken = token
+
+	page := template.ToHTML(d)
+	prefix := templatesshared.FRAGMENT_PLACEHOLDER_PREFIX + token + ":"
+
+	for {
+		start := strings.Index(page, prefix)
+		if start < 0 {
+			break
+		}
+
+		length := strings.Index(page[start:], templatesshared.FRAGMENT_PLACEHOLDER_SUFFIX)
+		if length < 0 {
+			break
+		}
+
+		if _, err := io.WriteString(w, page[:start]); err != nil {
+			return err
+		}
+
+		name := page[start+len(prefix) : start+length]
+		if fragment := d.findFragment(name); fragment != nil {
+			if err := fragment.Render(ctx, w); err != nil {
+				return err
+			}
+		}
+
+		page = page[start+length+len(templatesshared.FRAGMENT_PLACEHOLDER_SUFFIX):]
+	}
+
+	_, err = io.WriteString(w, page)
+	return err
+}
+
+// fragmentToken returns a random token for the fragment placeholders
+func fragmentToken() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// findFragment returns the fragment with the name, or nil if there is
+// nothing to render
+func (d *dashboard) findFragment(name string) types.Fragment {
+	kind, position, _ := strings.Cut(name, ":")
+
+	// The fragments of the regions are named "region:<region>:<index>"
+	if kind == templatesshared.FRAGMENT_REGION {
+		separator := strings.LastIndex(position, ":")
+		if separator < 0 {
+			return nil
+		}
+		index, _ := strconv.Atoi(position[separator+1:])
+		if fragments := d.GetRegionFragments(position[:separator]); index < len(fragments) {
+			return fragments[index]
+		}
+		return nil
+	}
+
+	index, _ := strconv.Atoi(position)
+
+	switch kind {
+	case templatesshared.FRAGMENT_CONTENT:
+		if d.contentFragment != nil {
+			return d.contentFragment
+		}
+		return types.FragmentHTML(d.content)
+	case templatesshared.FRAGMENT_FOOTER:
+		if footer := d.GetFooter(); footer != nil {
+			if footer.Fragment != nil {
+				return footer.Fragment
+			}
+			return types.FragmentHTML(footer.HTML)
+		}
+	case templatesshared.FRAGMENT_MODAL_BODY:
+		if modals := d.GetModals(); index < len(modals) {
+			if modals[index].Body != nil {
+				return modals[index].Body
+			}
+			return types.FragmentHTML(modals[index].Content)
+		}
+	case templatesshared.FRAGMENT_WIDGET:
+		if widgets := d.GetWidgets(); index < len(widgets) {
+			return widgets[index].Body
+		}
+	case templatesshared.FRAGMENT_MODAL_FOOTER:
+		if modals := d.GetModals(); index < len(modals) {
+			if modals[index].FooterFragment != nil {
+				return modals[index].FooterFragment
+			}
+			return types.FragmentHTML(modals[index].Footer)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/req"
+)
+
+// searchResponse is the JSON document served by the search handler
+type searchResponse struct {
+	Query  string                    `json:"query"`
+	Groups []types.SearchResultGroup `json:"groups"`
+	Error  string                    `json:"error,omitempty"`
+}
+
+// SearchHandler returns a handler which serves the typeahead results of the
+// navbar search box as JSON. The query is read from the "q" parameter and
+// answered by the search provider, with the results grouped by category
+func SearchHandler(provider types.SearchProviderInterface) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := req.GetStringTrimmed(r, "q")
+		response := searchResponse{
+			Query:  query,
+			Groups: []types.SearchResultGroup{},
+		}
+
+		if query == "" || provider == nil {
+			searchRespond(w, http.StatusOK, response)
+			return
+		}
+
+		results, err := provider.Search(r.Context(), query)
+
+		if err != nil {
+			log.Println(err.Error())
+			response.Error = "Search failed"
+			searchRespond(w, http.StatusInternalServerError, response)
+			return
+		}
+
+		response.Groups = searchResultsGroup(results)
+		searchRespond(w, http.StatusOK, response)
+	}
+}
+
+// searchResultsGroup groups the results by category, keeping the
+// categories in the order in which they first appear. The results linking
+// to other than relative or http(s) URLs, e.g. javascript: URLs, are skipped
+func searchResultsGroup(results []types.SearchResult) []types.SearchResultGroup {
+	groups := []types.SearchResultGroup{}
+	index := map[string]int{}
+
+	for _, result := range results {
+		if !searchResultURLAllowed(result.URL) {
+			log.Println("search: skipping the result " + result.Title + " with the URL scheme not allowed")
+			continue
+		}
+
+		i, found := index[result.Category]
+		if !found {
+			i = len(groups)
+			index[result.Category] = i
+			groups = append(groups, types.SearchResultGroup{Category: result.Category})
+		}
+		groups[i].Results = append(groups[i].Results, result)
+	}
+
+	return groups
+}
+
+// searchResultURLAllowed returns whether the result URL can be linked to:
+// an empty or relative URL, or an http(s) URL
+func searchResultURLAllowed(resultURL string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(resultURL))
+	if err != nil {
+		return false
+	}
+
+	switch strings.ToLower(parsed.Scheme) {
+	case "", "http", "https":
+		return true
+	default:
+		return false
+	}
+}
+
+// searchRespond writes the search response as JSON
+func searchRespond(w http.ResponseWriter, status int, response searchResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Println(err.Error())
+	}
+}
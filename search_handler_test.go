@@ -0,0 +1,144 @@
+package dashboard_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+type searchResponse struct {
+	Query  string                    `json:"query"`
+	Groups []types.SearchResultGroup `json:"groups"`
+	Error  string                    `json:"error"`
+}
+
+func TestSearchHandler(t *testing.T) {
+	provider := types.SearchProviderFunc(func(ctx context.Context, query string) ([]types.SearchResult, error) {
+		if query == "fail" {
+			return nil, errors.New("database unavailable")
+		}
+		return []types.SearchResult{
+			{Title: "User " + query, URL: "/users/1", Icon: "bi bi-person", Category: "Users"},
+			{Title: "Order " + query, URL: "https://shop.example.com/orders/1", Category: "Orders"},
+			{Title: "Other user " + query, URL: "/users/2", Category: "Users"},
+			{Title: "Script " + query, URL: " JavaScript:alert(1)", Category: "Scripts"},
+			{Title: "Data " + query, URL: "data:text/html,<script>alert(1)</script>", Category: "Users"},
+		}, nil
+	})
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedGroups []string
+		expectedError  string
+	}{
+		{
+			name:           "grouped results",
+			query:          "q=42",
+			expectedStatus: http.StatusOK,
+			expectedGroups: []string{"Users", "Orders"},
+		},
+		{
+			name:           "empty query",
+			query:          "q=+",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "provider error",
+			query:          "q=fail",
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "Search failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/search?"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			dashboard.SearchHandler(provider).ServeHTTP(rr, req)
+
+			if rr.Code != tt.expectedStatus {
+				t.Errorf("handler returned wrong status code: got %v want %v", rr.Code, tt.expectedStatus)
+			}
+
+			if contentType := rr.Header().Get("Content-Type"); contentType != "application/json" {
+				t.Errorf("expected JSON content type, got %q", contentType)
+			}
+
+			var response searchResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+				t.Fatalf("invalid JSON response: %v", err)
+			}
+
+			if response.Groups == nil {
+				t.Error("expected groups to be an empty list rather than null")
+			}
+
+			if len(response.Groups) != len(tt.expectedGroups) {
+				t.Fatalf("expected %d groups, got %d", len(tt.expectedGroups), len(response.Groups))
+			}
+
+			for i, category := range tt.expectedGroups {
+				if response.Groups[i].Category != category {
+					t.Errorf("expected group %d to be %q, got %q", i, category, response.Groups[i].Category)
+				}
+			}
+
+			if len(tt.expectedGroups) > 0 && len(response.Groups[0].Results) != 2 {
+				t.Errorf("expected the users group to hold 2 results, got %d", len(response.Groups[0].Results))
+			}
+
+			if response.Error != tt.expectedError {
+				t.Errorf("expected error %q, got %q", tt.expectedError, response.Error)
+			}
+		})
+	}
+}
+
+func TestSearchBoxRendering(t *testing.T) {
+	templates := []string{
+		shared.TEMPLATE_BOOTSTRAP,
+		shared.TEMPLATE_TABLER,
+		shared.TEMPLATE_ADMINLTE,
+	}
+
+	for _, template := range templates {
+		t.Run(template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(template)
+			d.SetUser(types.User{FirstName: "Jane"})
+
+			if html := d.ToHTML(); strings.Contains(html, "data-dashboard-search") {
+				t.Error("expected no search box without a search handler")
+			}
+
+			d.SetSearchHandlerUrl("/search")
+			html := d.ToHTML()
+
+			expected := []string{
+				`action="/search"`,
+				`data-dashboard-search="true"`,
+				`data-search-results="true"`,
+				`name="q"`,
+				`var handlerUrl = "/search";`,
+				`var href = safeUrl(result.url);`,
+			}
+
+			for _, s := range expected {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+)
+
+// sessionKeepAliveResponse is the JSON response of the keep-alive handler
+type sessionKeepAliveResponse struct {
+	ExpiresAt string `json:"expires_at,omitempty"`
+	ExpiresIn int    `json:"expires_in"`
+	Error     string `json:"error,omitempty"`
+}
+
+// SessionKeepAliveHandler returns the handler of the "stay signed in"
+// button of the session timeout modal, posted to the KeepAliveURL of the
+// session timeout. The keepAlive hook extends the session of the request,
+// e.g. touching it in the session store, and returns the new expiry. An
+// error means the session cannot be extended, the page is then redirected
+// to the login URL. Check the CSRF token before the handler, with the
+// middleware of the application.
+//
+// Example:
+//
+//	http.Handle("/session/keep-alive", dashboard.SessionKeepAliveHandler(func(r *http.Request) (time.Time, error) {
+//		return sessions.Touch(r)
+//	}))
+func SessionKeepAliveHandler(keepAlive func(r *http.Request) (time.Time, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			sessionKeepAliveRespond(w, http.StatusMethodNotAllowed, sessionKeepAliveResponse{Error: "Method not allowed"})
+			return
+		}
+
+		expiresAt, err := keepAlive(r)
+
+		if err != nil {
+			log.Println(err.Error())
+			sessionKeepAliveRespond(w, http.StatusUnauthorized, sessionKeepAliveResponse{Error: "Session expired"})
+			return
+		}
+
+		sessionKeepAliveRespond(w, http.StatusOK, sessionKeepAliveResponse{
+			ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+			ExpiresIn: int(time.Until(expiresAt).Seconds()),
+		})
+	}
+}
+
+// sessionKeepAliveRespond writes the keep-alive response as JSON
+func sessionKeepAliveRespond(w http.ResponseWriter, status int, response sessionKeepAliveResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Println(err.Error())
+	}
+}
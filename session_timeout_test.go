@@ -0,0 +1,187 @@
+package dashboard_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestSessionTimeout(t *testing.T) {
+	d := dashboard.New()
+
+	if d.GetSessionTimeout() != nil {
+		t.Fatal("expected no session timeout by default")
+	}
+
+	d.SetSessionTimeout(types.SessionTimeout{KeepAliveURL: "/keep-alive"})
+	if d.GetSessionTimeout() != nil {
+		t.Error("expected no session timeout without expiry")
+	}
+
+	d.SetSessionTimeout(types.SessionTimeout{ExpiresAt: time.Now().Add(30 * time.Minute)})
+	if d.GetSessionTimeout() == nil {
+		t.Error("expected the session timeout to be set")
+	}
+}
+
+func TestSessionTimeoutRendering(t *testing.T) {
+	templates := []string{shared.TEMPLATE_BOOTSTRAP, shared.TEMPLATE_TABLER, shared.TEMPLATE_ADMINLTE}
+
+	tests := []struct {
+		name           string
+		sessionTimeout *types.SessionTimeout
+		loginURL       string
+		expected       []string
+		notExpected    []string
+	}{
+		{
+			name:        "none",
+			notExpected: []string{`id="SessionTimeout"`, "data-session-timeout"},
+		},
+		{
+			name: "default",
+			sessionTimeout: &types.SessionTimeout{
+				ExpiresAt:    time.Now().Add(30 * time.Minute),
+				KeepAliveURL: "/session/keep-alive",
+				CSRFToken:    "token",
+			},
+			loginURL: "/auth/login",
+			expected: []string{
+				`id="SessionTimeout"`,
+				`aria-labelledby="SessionTimeoutLabel"`,
+				`id="SessionTimeoutLabel"`,
+				`>Your session is about to expire</h5>`,
+				`You will be signed out in <strong data-session-timeout-countdown="true">2:00</strong>`,
+				`data-session-timeout-keep-alive="true" type="button">Stay signed in</button>`,
+				`"keepAliveUrl":"/session/keep-alive"`,
+				`"loginUrl":"/auth/login"`,
+				`"csrfFieldName":"csrf_token","csrfToken":"token"`,
+				`"warnBefore":120000`,
+				`var storageKey = 'dashboard-session-deadline';`,
+				`if (deadline > load()) {`,
+				`window.addEventListener('storage'`,
+			},
+		},
+		{
+			name: "without keep alive",
+			sessionTimeout: &types.SessionTimeout{
+				ExpiresAt:  time.Now().Add(-time.Minute),
+				WarnBefore: 90 * time.Second,
+			},
+			expected: []string{
+				`>1:30</strong>`,
+				`"expiresIn":0`,
+				`"loginUrl":"/login"`,
+			},
+			notExpected: []string{`data-session-timeout-keep-alive="true"`},
+		},
+	}
+
+	for _, template := range templates {
+		for _, tt := range tests {
+			t.Run(template+" "+tt.name, func(t *testing.T) {
+				d := dashboard.New()
+				d.SetTemplate(template)
+				d.SetUser(types.User{FirstName: "Jane"})
+				d.SetLoginURL(tt.loginURL)
+				if tt.sessionTimeout != nil {
+					d.SetSessionTimeout(*tt.sessionTimeout)
+				}
+
+				html := d.ToHTML()
+
+				if tt.sessionTimeout != nil {
+					backdrop := `data-bs-backdrop="static"`
+					if template == shared.TEMPLATE_ADMINLTE {
+						backdrop = `data-backdrop="static"`
+					}
+					if !strings.Contains(html, backdrop) {
+						t.Errorf("expected HTML to contain %q", backdrop)
+					}
+				}
+
+				for _, s := range tt.expected {
+					if !strings.Contains(html, s) {
+						t.Errorf("expected HTML to contain %q", s)
+					}
+				}
+				for _, s := range tt.notExpected {
+					if strings.Contains(html, s) {
+						t.Errorf("expected HTML not to contain %q", s)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestSessionKeepAliveHandler(t *testing.T) {
+	handler := dashboard.SessionKeepAliveHandler(func(r *http.Request) (time.Time, error) {
+		if r.Header.Get("Cookie") == "" {
+			return time.Time{}, errors.New("no session")
+		}
+		return time.Now().Add(30 * time.Minute), nil
+	})
+
+	tests := []struct {
+		name           string
+		method         string
+		cookie         string
+		expectedStatus int
+		expectedError  string
+	}{
+		{name: "extended", method: http.MethodPost, cookie: "session=1", expectedStatus: http.StatusOK},
+		{name: "expired", method: http.MethodPost, expectedStatus: http.StatusUnauthorized, expectedError: "Session expired"},
+		{name: "get", method: http.MethodGet, cookie: "session=1", expectedStatus: http.StatusMethodNotAllowed, expectedError: "Method not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(tt.method, "/session/keep-alive", nil)
+			if tt.cookie != "" {
+				request.Header.Set("Cookie", tt.cookie)
+			}
+			recorder := httptest.NewRecorder()
+
+			handler(recorder, request)
+
+			if recorder.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tt.expectedStatus, recorder.Code)
+			}
+
+			if recorder.Header().Get("Cache-Control") != "no-store" {
+				t.Error("expected the response not to be cached")
+			}
+
+			var response struct {
+				ExpiresAt string `json:"expires_at"`
+				ExpiresIn int    `json:"expires_in"`
+				Error     string `json:"error"`
+			}
+			if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+				t.Fatal(err)
+			}
+
+			if response.Error != tt.expectedError {
+				t.Errorf("expected the error %q, got %q", tt.expectedError, response.Error)
+			}
+
+			if tt.expectedStatus == http.StatusOK {
+				if response.ExpiresIn < 1790 || response.ExpiresIn > 1800 {
+					t.Errorf("expected the session to expire in 30 minutes, got %d seconds", response.ExpiresIn)
+				}
+				if _, err := time.Parse(time.RFC3339, response.ExpiresAt); err != nil {
+					t.Errorf("expected an RFC 3339 expiry, got %q", response.ExpiresAt)
+				}
+			}
+		})
+	}
+}
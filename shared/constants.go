@@ -2,6 +2,10 @@ package shared
 
 type ThemeNameContextKey struct{}
 
+type SidebarCollapsedContextKey struct{}
+
+type LocaleContextKey struct{}
+
 // Template constants
 const TEMPLATE_ADMINLTE = "adminlte"
 const TEMPLATE_BOOTSTRAP = "bootstrap"
@@ -16,5 +20,72 @@ const TEMPLATE_DEFAULT = TEMPLATE_BOOTSTRAP
 const (
 	TEMPLATE_BOOTSTRAP_MENU_TYPE_MODAL     = "modal"
 	TEMPLATE_BOOTSTRAP_MENU_TYPE_OFFCANVAS = "offcanvas"
+	TEMPLATE_BOOTSTRAP_MENU_TYPE_SIDEBAR   = "sidebar"
 	THEME_COOKIE_KEY                       = "theme"
+	SIDEBAR_COLLAPSED_COOKIE_KEY           = "sidebar_collapsed"
+)
+
+// Layout constants for the tabler template
+const (
+	TEMPLATE_TABLER_LAYOUT_HORIZONTAL     = "horizontal"
+	TEMPLATE_TABLER_LAYOUT_VERTICAL       = "vertical"
+	TEMPLATE_TABLER_LAYOUT_VERTICAL_RIGHT = "vertical-right"
+	TEMPLATE_TABLER_LAYOUT_CONDENSED      = "condensed"
+	TEMPLATE_TABLER_LAYOUT_COMBINED       = "combined"
+	TEMPLATE_TABLER_LAYOUT_FLUID          = "fluid"
+	TEMPLATE_TABLER_LAYOUT_FLUID_VERTICAL = "fluid-vertical"
+	TEMPLATE_TABLER_LAYOUT_BOXED          = "boxed"
+	TEMPLATE_TABLER_LAYOUT_NAVBAR_DARK    = "navbar-dark"
+	TEMPLATE_TABLER_LAYOUT_NAVBAR_OVERLAP = "navbar-overlap"
+)
+
+// Sidebar skin constants for the adminlte template
+const (
+	TEMPLATE_ADMINLTE_SIDEBAR_SKIN_DARK  = "dark"
+	TEMPLATE_ADMINLTE_SIDEBAR_SKIN_LIGHT = "light"
+)
+
+// Text size constants for the adminlte template
+const (
+	TEMPLATE_ADMINLTE_TEXT_SIZE_DEFAULT = ""
+	TEMPLATE_ADMINLTE_TEXT_SIZE_SMALL   = "sm"
+)
+
+// Page layout constants, the auth layout is a centred card for the sign in,
+// registration and password pages, without the navigation of the dashboard,
+// the error layout is the same card for the standalone error pages
+const (
+	PAGE_LAYOUT_DASHBOARD = "dashboard"
+	PAGE_LAYOUT_AUTH      = "auth"
+	PAGE_LAYOUT_ERROR     = "error"
+)
+
+// REQUEST_ID_HEADER is the header carrying the ID of the request, read from
+// the request when set by a proxy and written to the response
+const REQUEST_ID_HEADER = "X-Request-ID"
+
+// User status constants, shown as the status dot of the avatar
+const (
+	USER_STATUS_ONLINE  = "online"
+	USER_STATUS_AWAY    = "away"
+	USER_STATUS_BUSY    = "busy"
+	USER_STATUS_OFFLINE = "offline"
+)
+
+// Environment indicator constants, the environment variables the
+// environment is read from and how the environment is shown
+const (
+	ENVIRONMENT_VARIABLE         = "DASHBOARD_ENVIRONMENT"
+	ENVIRONMENT_COLOR_VARIABLE   = "DASHBOARD_ENVIRONMENT_COLOR"
+	ENVIRONMENT_DISPLAY_VARIABLE = "DASHBOARD_ENVIRONMENT_DISPLAY"
+
+	ENVIRONMENT_DISPLAY_RIBBON = "ribbon"
+	ENVIRONMENT_DISPLAY_BADGE  = "badge"
+)
+
+// Avatar shape constants, the shapes of the generated initials avatars
+const (
+	AVATAR_SHAPE_CIRCLE  = "circle"
+	AVATAR_SHAPE_ROUNDED = "rounded"
+	AVATAR_SHAPE_SQUARE  = "square"
 )
@@ -0,0 +1,33 @@
+package shared
+
+import "strings"
+
+// Text direction constants, as used in the html dir attribute
+const (
+	DIRECTION_LTR = "ltr"
+	DIRECTION_RTL = "rtl"
+)
+
+// languagesRTL are the languages written from right to left
+var languagesRTL = map[string]bool{
+	"ar":  true, // Arabic
+	"ckb": true, // Central Kurdish
+	"dv":  true, // Divehi
+	"fa":  true, // Persian
+	"he":  true, // Hebrew
+	"ps":  true, // Pashto
+	"sd":  true, // Sindhi
+	"ug":  true, // Uyghur
+	"ur":  true, // Urdu
+	"yi":  true, // Yiddish
+}
+
+// LocaleDirection returns the text direction of the locale,
+// e.g. DIRECTION_RTL for "ar" or "he-IL"
+func LocaleDirection(locale string) string {
+	language, _, _ := strings.Cut(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"), "-")
+	if languagesRTL[strings.ToLower(language)] {
+		return DIRECTION_RTL
+	}
+	return DIRECTION_LTR
+}
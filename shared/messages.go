@@ -0,0 +1,173 @@
+package shared
+
+// LOCALE_DEFAULT is the locale of the built-in messages
+const LOCALE_DEFAULT = "en"
+
+// Message keys of the built-in UI strings
+const (
+	MESSAGE_ACTIONS                = "actions"
+	MESSAGE_ALREADY_REGISTERED     = "already_registered"
+	MESSAGE_BACK_TO_HOME           = "back_to_home"
+	MESSAGE_BACK_TO_SIGN_IN        = "back_to_sign_in"
+	MESSAGE_BACK_TO_TOP            = "back_to_top"
+	MESSAGE_BREADCRUMB             = "breadcrumb"
+	MESSAGE_CLOSE                  = "close"
+	MESSAGE_COMMAND                = "command"
+	MESSAGE_COMMANDS               = "commands"
+	MESSAGE_COMMAND_HELP           = "command_help"
+	MESSAGE_COMMAND_NO_RESULTS     = "command_no_results"
+	MESSAGE_COMMAND_PALETTE        = "command_palette"
+	MESSAGE_COMMAND_PLACEHOLDER    = "command_placeholder"
+	MESSAGE_CONFIRM_PASSWORD       = "confirm_password"
+	MESSAGE_DASHBOARD              = "dashboard"
+	MESSAGE_EMAIL                  = "email"
+	MESSAGE_ERROR_401_MESSAGE      = "error_401_message"
+	MESSAGE_ERROR_401_TITLE        = "error_401_title"
+	MESSAGE_ERROR_403_MESSAGE      = "error_403_message"
+	MESSAGE_ERROR_403_TITLE        = "error_403_title"
+	MESSAGE_ERROR_404_MESSAGE      = "error_404_message"
+	MESSAGE_ERROR_404_TITLE        = "error_404_title"
+	MESSAGE_ERROR_500_MESSAGE      = "error_500_message"
+	MESSAGE_ERROR_500_TITLE        = "error_500_title"
+	MESSAGE_ERROR_503_MESSAGE      = "error_503_message"
+	MESSAGE_ERROR_503_TITLE        = "error_503_title"
+	MESSAGE_FORGOT_PASSWORD        = "forgot_password"
+	MESSAGE_FORGOT_PASSWORD_HELP   = "forgot_password_help"
+	MESSAGE_HOME                   = "home"
+	MESSAGE_IMPERSONATING          = "impersonating"
+	MESSAGE_LOGIN                  = "login"
+	MESSAGE_MAINTENANCE_ETA        = "maintenance_eta"
+	MESSAGE_MAINTENANCE_MESSAGE    = "maintenance_message"
+	MESSAGE_MAINTENANCE_TITLE      = "maintenance_title"
+	MESSAGE_MAIN_NAVIGATION        = "main_navigation"
+	MESSAGE_MENU                   = "menu"
+	MESSAGE_NAME                   = "name"
+	MESSAGE_NOT_AVAILABLE          = "not_available"
+	MESSAGE_NOT_REGISTERED         = "not_registered"
+	MESSAGE_PASSWORD               = "password"
+	MESSAGE_QUICK_ACCESS           = "quick_access"
+	MESSAGE_REGISTER               = "register"
+	MESSAGE_REMEMBER_ME            = "remember_me"
+	MESSAGE_REQUEST_ID             = "request_id"
+	MESSAGE_SEARCH                 = "search"
+	MESSAGE_SEARCH_NO_RESULTS      = "search_no_results"
+	MESSAGE_SEARCH_PLACEHOLDER     = "search_placeholder"
+	MESSAGE_SEE_ALL_MESSAGES       = "see_all_messages"
+	MESSAGE_SEND_RESET_LINK        = "send_reset_link"
+	MESSAGE_SESSION_EXPIRING       = "session_expiring"
+	MESSAGE_SESSION_EXPIRING_HELP  = "session_expiring_help"
+	MESSAGE_SHOW_THEME_MENU        = "show_theme_menu"
+	MESSAGE_SIGNED_IN_AS           = "signed_in_as"
+	MESSAGE_SIGN_IN                = "sign_in"
+	MESSAGE_SKIP_TO_CONTENT        = "skip_to_content"
+	MESSAGE_STATUS_AWAY            = "status_away"
+	MESSAGE_STATUS_BUSY            = "status_busy"
+	MESSAGE_STATUS_OFFLINE         = "status_offline"
+	MESSAGE_STATUS_ONLINE          = "status_online"
+	MESSAGE_STAY_SIGNED_IN         = "stay_signed_in"
+	MESSAGE_STOP_IMPERSONATING     = "stop_impersonating"
+	MESSAGE_THEME                  = "theme"
+	MESSAGE_TOGGLE_NAVIGATION      = "toggle_navigation"
+	MESSAGE_TOGGLE_SIDEBAR         = "toggle_sidebar"
+	MESSAGE_TOOLBAR                = "toolbar"
+	MESSAGE_TWO_FACTOR_CODE        = "two_factor_code"
+	MESSAGE_TWO_FACTOR_HELP        = "two_factor_help"
+	MESSAGE_USER                   = "user"
+	MESSAGE_USER_MENU              = "user_menu"
+	MESSAGE_VERIFY                 = "verify"
+	MESSAGE_VIEW_ALL_NOTIFICATIONS = "view_all_notifications"
+)
+
+// messagesDefault are the English built-in messages
+var messagesDefault = map[string]string{
+	MESSAGE_ACTIONS:                "Actions",
+	MESSAGE_ALREADY_REGISTERED:     "Already have an account?",
+	MESSAGE_BACK_TO_HOME:           "Back to home",
+	MESSAGE_BACK_TO_SIGN_IN:        "Back to sign in",
+	MESSAGE_BACK_TO_TOP:            "Back to top",
+	MESSAGE_BREADCRUMB:             "Breadcrumb",
+	MESSAGE_CLOSE:                  "Close",
+	MESSAGE_COMMAND:                "Command",
+	MESSAGE_COMMANDS:               "Commands",
+	MESSAGE_COMMAND_HELP:           "↑ ↓ to navigate, Enter to open, Esc to close",
+	MESSAGE_COMMAND_NO_RESULTS:     "No matching commands",
+	MESSAGE_COMMAND_PALETTE:        "Command palette",
+	MESSAGE_COMMAND_PLACEHOLDER:    "Type a command or search...",
+	MESSAGE_CONFIRM_PASSWORD:       "Confirm password",
+	MESSAGE_DASHBOARD:              "Dashboard",
+	MESSAGE_EMAIL:                  "Email address",
+	MESSAGE_ERROR_401_MESSAGE:      "You need to sign in to view this page.",
+	MESSAGE_ERROR_401_TITLE:        "Sign in required",
+	MESSAGE_ERROR_403_MESSAGE:      "You don't have permission to view this page.",
+	MESSAGE_ERROR_403_TITLE:        "Access denied",
+	MESSAGE_ERROR_404_MESSAGE:      "The page you are looking for doesn't exist or has been moved.",
+	MESSAGE_ERROR_404_TITLE:        "Page not found",
+	MESSAGE_ERROR_500_MESSAGE:      "An unexpected error occurred. Please try again later.",
+	MESSAGE_ERROR_500_TITLE:        "Something went wrong",
+	MESSAGE_ERROR_503_MESSAGE:      "The service is temporarily unavailable. Please try again later.",
+	MESSAGE_ERROR_503_TITLE:        "Service unavailable",
+	MESSAGE_FORGOT_PASSWORD:        "Forgot password?",
+	MESSAGE_FORGOT_PASSWORD_HELP:   "Enter your email address and we will send you a link to reset your password.",
+	MESSAGE_HOME:                   "Home",
+	MESSAGE_IMPERSONATING:          "Impersonating",
+	MESSAGE_LOGIN:                  "Login",
+	MESSAGE_MAINTENANCE_ETA:        "Expected back by",
+	MESSAGE_MAINTENANCE_MESSAGE:    "We are performing scheduled maintenance. Please check back soon.",
+	MESSAGE_MAINTENANCE_TITLE:      "Down for maintenance",
+	MESSAGE_MAIN_NAVIGATION:        "Main navigation",
+	MESSAGE_MENU:                   "Menu",
+	MESSAGE_NAME:                   "Name",
+	MESSAGE_NOT_AVAILABLE:          "n/a",
+	MESSAGE_NOT_REGISTERED:         "Don't have an account yet?",
+	MESSAGE_PASSWORD:               "Password",
+	MESSAGE_QUICK_ACCESS:           "Quick Access",
+	MESSAGE_REGISTER:               "Register",
+	MESSAGE_REMEMBER_ME:            "Remember me",
+	MESSAGE_REQUEST_ID:             "Request ID",
+	MESSAGE_SEARCH:                 "Search",
+	MESSAGE_SEARCH_NO_RESULTS:      "No results",
+	MESSAGE_SEARCH_PLACEHOLDER:     "Search...",
+	MESSAGE_SEE_ALL_MESSAGES:       "See All Messages",
+	MESSAGE_SEND_RESET_LINK:        "Send reset link",
+	MESSAGE_SESSION_EXPIRING:       "Your session is about to expire",
+	MESSAGE_SESSION_EXPIRING_HELP:  "You will be signed out in",
+	MESSAGE_SHOW_THEME_MENU:        "Show theme menu",
+	MESSAGE_SIGNED_IN_AS:           "Signed in as",
+	MESSAGE_SIGN_IN:                "Sign in",
+	MESSAGE_SKIP_TO_CONTENT:        "Skip to main content",
+	MESSAGE_STATUS_AWAY:            "Away",
+	MESSAGE_STATUS_BUSY:            "Busy",
+	MESSAGE_STATUS_OFFLINE:         "Offline",
+	MESSAGE_STATUS_ONLINE:          "Online",
+	MESSAGE_STAY_SIGNED_IN:         "Stay signed in",
+	MESSAGE_STOP_IMPERSONATING:     "Stop impersonating",
+	MESSAGE_THEME:                  "Theme",
+	MESSAGE_TOGGLE_NAVIGATION:      "Toggle navigation",
+	MESSAGE_TOGGLE_SIDEBAR:         "Toggle sidebar",
+	MESSAGE_TOOLBAR:                "Toolbar",
+	MESSAGE_TWO_FACTOR_CODE:        "Authentication code",
+	MESSAGE_TWO_FACTOR_HELP:        "Enter the code from your authenticator app.",
+	MESSAGE_USER:                   "User",
+	MESSAGE_USER_MENU:              "User menu",
+	MESSAGE_VERIFY:                 "Verify",
+	MESSAGE_VIEW_ALL_NOTIFICATIONS: "View All Notifications",
+}
+
+// MessageDefault returns the English built-in message for the key,
+// or the key itself when the key is unknown
+func MessageDefault(key string) string {
+	if message, ok := messagesDefault[key]; ok {
+		return message
+	}
+	return key
+}
+
+// MessagesDefault returns a copy of the English built-in messages, keyed by
+// the MESSAGE_* constants, as a starting point for translations
+func MessagesDefault() map[string]string {
+	messages := make(map[string]string, len(messagesDefault))
+	for key, message := range messagesDefault {
+		messages[key] = message
+	}
+	return messages
+}
@@ -0,0 +1,14 @@
+package shared
+
+// Layout region constants, the regions are rendered by all templates at the
+// same spots of the page, each region holds any number of ordered fragments
+const (
+	REGION_NAVBAR_START    = "navbar-start"    // start of the navbar, after the logo
+	REGION_NAVBAR_END      = "navbar-end"      // end of the navbar, before the theme and user menus
+	REGION_SIDEBAR_TOP     = "sidebar-top"     // top of the sidebar or menu panel, before the menu
+	REGION_SIDEBAR_BOTTOM  = "sidebar-bottom"  // bottom of the sidebar or menu panel, after the menu
+	REGION_PAGE_HEADER_END = "page-header-end" // end of the page header, next to the actions
+	REGION_CONTENT_BEFORE  = "content-before"  // main content area, before the content
+	REGION_CONTENT_AFTER   = "content-after"   // main content area, after the content
+	REGION_BODY_END        = "body-end"        // end of the body, after the layout and the modals
+)
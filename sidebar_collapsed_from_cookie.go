@@ -0,0 +1,24 @@
+package dashboard
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/dracory/dashboard/shared"
+)
+
+// SidebarCollapsedRetrieveFromCookie returns the stored sidebar state,
+// and whether a valid state was found at all
+func SidebarCollapsedRetrieveFromCookie(r *http.Request) (collapsed bool, found bool) {
+	sidebarCookie, err := r.Cookie(shared.SIDEBAR_COLLAPSED_COOKIE_KEY)
+
+	if err != nil {
+		if err != http.ErrNoCookie {
+			log.Println(err.Error())
+		}
+
+		return false, false
+	}
+
+	return sidebarCollapsedParse(sidebarCookie.Value)
+}
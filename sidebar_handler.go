@@ -0,0 +1,52 @@
+package dashboard
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/req"
+	"github.com/samber/lo"
+)
+
+// SidebarHandler stores the supplied sidebar state ("collapsed" = 1 or 0) in a cookie.
+// If a redirect URL is supplied the client is redirected to it, otherwise
+// the handler responds with 204 No Content, which suits the background
+// requests sent by the template scripts on each toggle.
+func SidebarHandler(w http.ResponseWriter, r *http.Request) {
+	collapsed := req.GetStringTrimmed(r, "collapsed")
+	redirect := req.GetStringTrimmed(r, "redirect")
+
+	// Only set cookie if the state is recognised
+	if value, ok := sidebarCollapsedParse(collapsed); ok {
+		secureCookies := lo.Ternary(r.TLS == nil, false, true)
+		cookie := http.Cookie{
+			Name:    shared.SIDEBAR_COLLAPSED_COOKIE_KEY,
+			Value:   lo.Ternary(value, "1", "0"),
+			Path:    "/",
+			Secure:  secureCookies,
+			Expires: time.Now().Add(365 * 24 * time.Hour),
+		}
+		http.SetCookie(w, &cookie)
+		r.AddCookie(&cookie)
+	}
+
+	if redirect == "" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// sidebarCollapsedParse converts a request or cookie value to a sidebar state
+func sidebarCollapsedParse(value string) (collapsed bool, ok bool) {
+	switch value {
+	case "1", "true", "yes", "on":
+		return true, true
+	case "0", "false", "no", "off":
+		return false, true
+	}
+
+	return false, false
+}
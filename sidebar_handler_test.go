@@ -0,0 +1,94 @@
+package dashboard_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+)
+
+func TestSidebarHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedValue  string
+		expectedPath   string
+	}{
+		{
+			name:           "collapsed without redirect",
+			query:          "collapsed=1",
+			expectedStatus: http.StatusNoContent,
+			expectedValue:  "1",
+		},
+		{
+			name:           "expanded without redirect",
+			query:          "collapsed=false",
+			expectedStatus: http.StatusNoContent,
+			expectedValue:  "0",
+		},
+		{
+			name:           "collapsed with redirect",
+			query:          "collapsed=true&redirect=/dashboard",
+			expectedStatus: http.StatusFound,
+			expectedValue:  "1",
+			expectedPath:   "/dashboard",
+		},
+		{
+			name:           "invalid state",
+			query:          "collapsed=maybe",
+			expectedStatus: http.StatusNoContent,
+			expectedValue:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/sidebar?"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			http.HandlerFunc(dashboard.SidebarHandler).ServeHTTP(rr, req)
+
+			if rr.Code != tt.expectedStatus {
+				t.Errorf("handler returned wrong status code: got %v want %v", rr.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedPath != "" {
+				location, err := rr.Result().Location()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if location.Path != tt.expectedPath {
+					t.Errorf("handler returned wrong redirect path: got %v want %v", location.Path, tt.expectedPath)
+				}
+			}
+
+			var sidebarCookie *http.Cookie
+			for _, cookie := range rr.Result().Cookies() {
+				if cookie.Name == shared.SIDEBAR_COLLAPSED_COOKIE_KEY {
+					sidebarCookie = cookie
+					break
+				}
+			}
+
+			if tt.expectedValue == "" {
+				if sidebarCookie != nil {
+					t.Error("Expected no sidebar cookie to be set")
+				}
+				return
+			}
+
+			if sidebarCookie == nil {
+				t.Fatal("Expected sidebar cookie to be set")
+			}
+			if sidebarCookie.Value != tt.expectedValue {
+				t.Errorf("Unexpected sidebar cookie value: got %v want %v", sidebarCookie.Value, tt.expectedValue)
+			}
+			if sidebarCookie.Path != "/" {
+				t.Errorf("Cookie path should be /, got %v", sidebarCookie.Path)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package dashboard
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dracory/dashboard/shared"
+)
+
+// SidebarMiddleware reads the sidebar state cookie and stores it in the request context.
+// Nothing is stored when the user has never toggled the sidebar, so the
+// dashboard keeps whatever was configured with SetSidebarCollapsed.
+func SidebarMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		collapsed, found := SidebarCollapsedRetrieveFromCookie(r)
+
+		if !found {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), shared.SidebarCollapsedContextKey{}, collapsed)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,97 @@
+package dashboard_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+)
+
+func TestSidebarMiddleware(t *testing.T) {
+	tests := []struct {
+		name          string
+		cookieValue   string
+		expectFound   bool
+		expectedValue bool
+	}{
+		{
+			name:        "no sidebar cookie",
+			cookieValue: "",
+			expectFound: false,
+		},
+		{
+			name:          "collapsed cookie",
+			cookieValue:   "1",
+			expectFound:   true,
+			expectedValue: true,
+		},
+		{
+			name:          "expanded cookie",
+			cookieValue:   "0",
+			expectFound:   true,
+			expectedValue: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.cookieValue != "" {
+				req.AddCookie(&http.Cookie{
+					Name:  shared.SIDEBAR_COLLAPSED_COOKIE_KEY,
+					Value: tt.cookieValue,
+				})
+			}
+
+			handlerCalled := false
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handlerCalled = true
+				collapsed, ok := r.Context().Value(shared.SidebarCollapsedContextKey{}).(bool)
+				if ok != tt.expectFound {
+					t.Fatalf("expected sidebar state found to be %v, got %v", tt.expectFound, ok)
+				}
+				if collapsed != tt.expectedValue {
+					t.Errorf("expected collapsed %v, got %v", tt.expectedValue, collapsed)
+				}
+			})
+
+			dashboard.SidebarMiddleware(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+			if !handlerCalled {
+				t.Fatal("next handler was not called")
+			}
+		})
+	}
+}
+
+func TestSidebarStateAppliedBeforeRendering(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(&http.Cookie{
+		Name:  shared.SIDEBAR_COLLAPSED_COOKIE_KEY,
+		Value: "1",
+	})
+
+	var html string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d := dashboard.New()
+		d.SetTemplate(shared.TEMPLATE_ADMINLTE)
+		d.SetHTTPRequest(r)
+
+		if !d.GetSidebarCollapsed() {
+			t.Error("expected sidebar to be collapsed from the stored state")
+		}
+
+		html = d.ToHTML()
+	})
+
+	dashboard.SidebarMiddleware(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	body := regexp.MustCompile(`<body[^>]*>`).FindString(html)
+	if !strings.Contains(body, "sidebar-collapse") {
+		t.Error("expected rendered body to contain the sidebar-collapse class")
+	}
+}
@@ -0,0 +1,75 @@
+package adminlte
+
+import (
+	"github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// brandThemeStyle converts a brand theme to AdminLTE style overrides.
+// AdminLTE 3 is built on Bootstrap 4, whose components do not use
+// CSS custom properties, so the affected selectors are overridden
+func brandThemeStyle(brand types.BrandTheme) string {
+	root := [][2]string{}
+	style := ""
+
+	for _, color := range shared.BrandThemeColors(brand) {
+		name, value := color[0], color[1]
+		root = append(root, [2]string{"--" + name, value})
+
+		darker := "color-mix(in srgb, " + value + " 85%, #000)"
+		style += shared.CSSRule(".btn-"+name, [][2]string{
+			{"background-color", value},
+			{"border-color", value},
+		})
+		style += shared.CSSRule(".btn-"+name+":hover, .btn-"+name+":focus, .btn-"+name+":active", [][2]string{
+			{"background-color", darker},
+			{"border-color", darker},
+		})
+		style += shared.CSSRule(".btn-outline-"+name, [][2]string{
+			{"color", value},
+			{"border-color", value},
+		})
+		style += shared.CSSRule(".btn-outline-"+name+":hover", [][2]string{
+			{"background-color", value},
+			{"border-color", value},
+		})
+		style += shared.CSSRule(".bg-"+name+", .badge-"+name, [][2]string{
+			{"background-color", value + " !important"},
+		})
+		style += shared.CSSRule(".text-"+name, [][2]string{
+			{"color", value + " !important"},
+		})
+	}
+
+	if brand.PrimaryColor != "" {
+		style += shared.CSSRule("a:not(.btn):not(.nav-link):not(.dropdown-item):not(.brand-link)", [][2]string{
+			{"color", brand.PrimaryColor},
+		})
+		style += shared.CSSRule(".nav-pills .nav-link.active, .sidebar-dark-primary .nav-sidebar > .nav-item > .nav-link.active, .sidebar-light-primary .nav-sidebar > .nav-item > .nav-link.active", [][2]string{
+			{"background-color", brand.PrimaryColor},
+		})
+	}
+
+	if brand.FontFamily != "" {
+		style += shared.CSSRule("body, .main-sidebar, .brand-link", [][2]string{
+			{"font-family", brand.FontFamily},
+		})
+	}
+
+	if brand.BorderRadius != "" {
+		style += shared.CSSRule(".btn, .card, .form-control, .dropdown-menu, .modal-content, .alert", [][2]string{
+			{"border-radius", brand.BorderRadius},
+		})
+	}
+
+	if brand.SpacingScale > 0 {
+		style += shared.CSSRule(".card-body", [][2]string{
+			{"padding", shared.Spacing(1.25, brand.SpacingScale)},
+		})
+		style += shared.CSSRule(".content-wrapper > .content", [][2]string{
+			{"padding", "0 " + shared.Spacing(0.5, brand.SpacingScale)},
+		})
+	}
+
+	return shared.CSSRule(":root", root) + style
+}
@@ -0,0 +1,47 @@
+package adminlte
+
+import (
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// commandPaletteModal creates the modal of the command palette, or nil
+// when the command palette is disabled
+func commandPaletteModal(dashboard types.DashboardInterface) *hb.Tag {
+	if !dashboard.GetCommandPaletteEnabled() {
+		return nil
+	}
+
+	input := hb.NewInput().
+		Type("search").
+		Class("form-control form-control-lg").
+		Placeholder(dashboard.Translate(shared.MESSAGE_COMMAND_PLACEHOLDER)).
+		Attr("autocomplete", "off").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_COMMAND)).
+		Attr("aria-controls", "commandPaletteResults").
+		Data("command-palette-input", "true")
+
+	results := hb.NewDiv().
+		ID("commandPaletteResults").
+		Class("list-group list-group-flush").
+		Attr("role", "listbox").
+		Data("command-palette-results", "true")
+
+	content := hb.NewDiv().Class("modal-content").
+		Child(hb.NewDiv().Class("modal-header").
+			Child(hb.NewH5().ID("commandPaletteLabel").Class("sr-only").Text(dashboard.Translate(shared.MESSAGE_COMMAND_PALETTE))).
+			Child(input)).
+		Child(hb.NewDiv().Class("modal-body p-0").Child(results)).
+		Child(hb.NewDiv().Class("modal-footer justify-content-start small text-muted").
+			Text(dashboard.Translate(shared.MESSAGE_COMMAND_HELP)))
+
+	return hb.NewDiv().
+		ID("CommandPalette").
+		Class("modal fade").
+		Attr("tabindex", "-1").
+		Attr("role", "dialog").
+		Attr("aria-labelledby", "commandPaletteLabel").
+		Attr("aria-hidden", "true").
+		Child(hb.NewDiv().Class("modal-dialog modal-dialog-scrollable modal-lg").Attr("role", "document").Child(content))
+}
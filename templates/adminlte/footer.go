@@ -0,0 +1,63 @@
+package adminlte
+
+import (
+	"github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// footer generates the main footer, or nil when the footer is not set
+func footer(dashboard types.DashboardInterface) *hb.Tag {
+	model := dashboard.GetFooter()
+	if model == nil {
+		return nil
+	}
+
+	mainFooter := hb.NewFooter().
+		Class("main-footer").
+		ClassIf(isTextSmall(dashboard.GetAdminLTEConfig()), "text-sm")
+
+	// Link columns
+	if len(model.Columns) > 0 {
+		row := hb.NewDiv().Class("row mb-2")
+		for _, column := range model.Columns {
+			links := hb.NewUL().Class("list-unstyled mb-0")
+			for _, link := range column.Links {
+				links.Child(hb.NewLI().Child(hb.NewA().
+					Href(lo.Ternary(link.URL == "", "#", link.URL)).
+					TargetIf(link.Target != "", link.Target).
+					Text(link.Title)))
+			}
+
+			row.Child(hb.NewDiv().Class("col-6 col-md").
+				ChildIf(column.Title != "", hb.NewH6().Class("text-uppercase font-weight-bold").Text(column.Title)).
+				Child(links))
+		}
+		mainFooter.Child(row)
+	}
+
+	// Version and environment on the right
+	if model.Version != "" || model.Environment != "" {
+		meta := hb.NewDiv().Class("float-right d-none d-sm-inline-block")
+		if model.Environment != "" {
+			meta.Child(hb.NewSpan().Class("badge badge-warning mr-2").Text(model.Environment))
+		}
+		if model.Version != "" {
+			meta.Child(hb.NewSpan().Text(model.Version))
+		}
+		mainFooter.Child(meta)
+	}
+
+	// Copyright on the left
+	if model.Copyright != "" {
+		mainFooter.Child(hb.NewStrong().Text(model.Copyright))
+	}
+
+	// Custom HTML
+	if model.HTML != "" || model.Fragment != nil {
+		mainFooter.Child(hb.NewDiv().Class("clearfix mt-2").Child(shared.FragmentPlaceholder(dashboard, shared.FRAGMENT_FOOTER)))
+	}
+
+	return mainFooter
+}
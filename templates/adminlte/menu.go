@@ -1,15 +1,18 @@
 package adminlte
 
 import (
+	"github.com/dracory/dashboard/shared"
+	templatesshared "github.com/dracory/dashboard/templates/shared"
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
 )
 
-// buildMenuItem creates a menu item for the dashboard menu
-func buildMenuItem(menuItem types.MenuItem, index int) *hb.Tag {
+// buildMenuItem creates a menu item for the dashboard menu. The icon of
+// the menu item is a list of CSS classes, e.g. "fas fa-home", not HTML
+func buildMenuItem(dashboard types.DashboardInterface, menuItem types.MenuItem, index int) *hb.Tag {
 	title := menuItem.Title
 	if title == "" {
-		title = "n/a"
+		title = dashboard.Translate(shared.MESSAGE_NOT_AVAILABLE)
 	}
 
 	url := menuItem.URL
@@ -33,7 +36,7 @@ func buildMenuItem(menuItem types.MenuItem, index int) *hb.Tag {
 	// Add icon if present
 	iconClass := "far fa-circle"
 	if icon != "" {
-		iconClass = icon
+		iconClass = string(icon)
 	}
 
 	iconEl := hb.I().Class("nav-icon " + iconClass)
@@ -41,7 +44,7 @@ func buildMenuItem(menuItem types.MenuItem, index int) *hb.Tag {
 
 	// Add title and caret for dropdown if has children
 	titleEl := hb.P()
-	titleEl.Child(hb.Span().HTML(title))
+	titleEl.Child(hb.Span().Text(title))
 
 	if hasChildren {
 		caret := hb.I().Class("right fas fa-angle-left")
@@ -57,7 +60,7 @@ func buildMenuItem(menuItem types.MenuItem, index int) *hb.Tag {
 	if hasChildren {
 		ul := hb.Ul().Class("nav nav-treeview")
 		for _, child := range children {
-			childItem := buildMenuItem(child, index+1)
+			childItem := buildMenuItem(dashboard, child, index+1)
 			ul.Child(childItem)
 		}
 		li.Child(ul)
@@ -72,7 +75,7 @@ func dashboardMenuNavbar(dashboard types.DashboardInterface) string {
 	menu := hb.Ul().Class("navbar-nav")
 
 	for i, item := range menuItems {
-		menuItem := buildMenuItem(item, i)
+		menuItem := buildMenuItem(dashboard, item, i)
 		menu.Child(menuItem)
 	}
 
@@ -81,29 +84,36 @@ func dashboardMenuNavbar(dashboard types.DashboardInterface) string {
 
 // menuOffcanvas generates the offcanvas menu HTML
 func menuOffcanvas(dashboard types.DashboardInterface) *hb.Tag {
-	// Main sidebar container
-	sidebar := hb.Div().Class("main-sidebar sidebar-dark-primary elevation-4")
+	config := dashboard.GetAdminLTEConfig()
+
+	// Main sidebar container, with the configured skin
+	sidebar := hb.Div().Class("main-sidebar " + sidebarSkinClass(config) + " elevation-4")
 
 	// Brand logo
-	brandLink := hb.A().Href("#").Class("brand-link")
+	brandLink := hb.A().Href("#").Class("brand-link").ClassIf(isTextSmall(config), "text-sm")
 	if dashboard.GetLogoImageURL() != "" {
-		brandLink.Child(hb.Img(dashboard.GetLogoImageURL()).Class("brand-image img-circle elevation-3"))
+		brandLink.Child(hb.Img(dashboard.GetLogoImageURL()).Class("brand-image img-circle elevation-3").Attr("aria-hidden", "true"))
 	}
-	brandLink.Child(hb.Span().Class("brand-text font-weight-light").HTML(dashboard.GetTitle()))
+	brandLink.Child(hb.Span().Class("brand-text font-weight-light").Text(dashboard.GetTitle()))
 	sidebar.Child(brandLink)
 
 	// Sidebar
 	sidebarInner := hb.Div().Class("sidebar")
 
+	// Sidebar top region
+	if region := templatesshared.Region(dashboard, shared.REGION_SIDEBAR_TOP); region != nil {
+		sidebarInner.Child(region.Class("mt-3"))
+	}
+
 	// User panel
 	user := dashboard.GetUser()
-	if user != nil && user.FirstName != "" {
+	if user != nil && user.Name() != "" {
 		userPanel := hb.Div().Class("user-panel mt-3 pb-3 mb-3 d-flex")
 		userPanel.Child(hb.Div().Class("image").Child(
-			hb.Img("https://www.gravatar.com/avatar/00000000000000000000000000000000?d=mp&f=y").Class("img-circle elevation-2").Style("width: 2.1rem"),
+			templatesshared.UserAvatar(dashboard, *user, 34, ""),
 		))
 		userPanel.Child(hb.Div().Class("info").Child(
-			hb.A().Href("#").Class("d-block").HTML(user.FirstName + " " + user.LastName),
+			hb.A().Href("#").Class("d-block").Text(user.Name()),
 		))
 		sidebarInner.Child(userPanel)
 	}
@@ -111,23 +121,30 @@ func menuOffcanvas(dashboard types.DashboardInterface) *hb.Tag {
 	// Sidebar search
 	searchDiv := hb.Div().Class("form-inline mt-2")
 	inputGroup := hb.Div().Class("input-group").Data("widget", "sidebar-search")
-	input := hb.Input().Class("form-control form-control-sidebar").Type("search").Placeholder("Search...").Attr("aria-label", "Search")
+	input := hb.Input().Class("form-control form-control-sidebar").Type("search").Placeholder(dashboard.Translate(shared.MESSAGE_SEARCH_PLACEHOLDER)).Attr("aria-label", dashboard.Translate(shared.MESSAGE_SEARCH))
 	inputGroup.Child(input)
 	inputGroupAppend := hb.Div().Class("input-group-append")
-	button := hb.Button().Class("btn btn-sidebar").Type("submit")
-	iconHTML := "<i class=\"fas fa-search fa-fw\"></i>"
+	button := hb.Button().Class("btn btn-sidebar").Type("submit").Attr("aria-label", dashboard.Translate(shared.MESSAGE_SEARCH))
+	iconHTML := "<i class=\"fas fa-search fa-fw\" aria-hidden=\"true\"></i>"
 	button.Child(hb.Raw(iconHTML))
 	inputGroupAppend.Child(button)
 	inputGroup.Child(inputGroupAppend)
 	searchDiv.Child(inputGroup)
 	sidebarInner.Child(searchDiv)
 
-	nav := hb.Nav().Class("mt-2")
+	nav := hb.Nav().Class("mt-2").Attr("aria-label", dashboard.Translate(shared.MESSAGE_MAIN_NAVIGATION))
 	ul := hb.Ul().Class("nav nav-pills nav-sidebar flex-column").Data("widget", "treeview").Role("menu").Data("accordion", "false")
+	ul.ClassIf(isTextSmall(config), "text-sm")
 	ul.Child(BuildSidebarMenu(dashboard))
 	nav.Child(ul)
 
 	sidebarInner.Child(nav)
+
+	// Sidebar bottom region
+	if region := templatesshared.Region(dashboard, shared.REGION_SIDEBAR_BOTTOM); region != nil {
+		sidebarInner.Child(region.Class("mt-3 pb-3"))
+	}
+
 	sidebar.Child(sidebarInner)
 
 	return sidebar
@@ -139,7 +156,7 @@ func BuildSidebarMenu(dashboard types.DashboardInterface) *hb.Tag {
 	menu := hb.Ul().Class("nav nav-pills nav-sidebar flex-column").Data("widget", "treeview").Role("menu")
 
 	for i, item := range menuItems {
-		menuItem := buildSidebarMenuItem(item, i)
+		menuItem := buildSidebarMenuItem(dashboard, item, i)
 		if menuItem != nil {
 			menu.Child(menuItem)
 		}
@@ -148,8 +165,14 @@ func BuildSidebarMenu(dashboard types.DashboardInterface) *hb.Tag {
 	return menu
 }
 
-// buildSidebarMenuItem builds a single sidebar menu item
-func buildSidebarMenuItem(item types.MenuItem, index int) *hb.Tag {
+// buildSidebarMenuItem builds a single sidebar menu item. The icon of the
+// menu item is a list of CSS classes, e.g. "fas fa-home", not HTML
+func buildSidebarMenuItem(dashboard types.DashboardInterface, item types.MenuItem, index int) *hb.Tag {
+	title := item.Title
+	if title == "" {
+		title = dashboard.Translate(shared.MESSAGE_NOT_AVAILABLE)
+	}
+
 	hasChildren := len(item.Children) > 0
 	icon := item.Icon
 	if icon == "" {
@@ -170,17 +193,22 @@ func buildSidebarMenuItem(item types.MenuItem, index int) *hb.Tag {
 		link.Href("#")
 	}
 	link.Class("nav-link")
+	link.ClassIf(item.IsActive, "active")
+	link.AttrIf(item.IsActive, "aria-current", "page")
+	if hasChildren {
+		link.Attr("aria-expanded", "false")
+	}
 
 	// Add icon
-	iconEl := hb.I().Class("nav-icon " + icon)
+	iconEl := hb.I().Class("nav-icon "+string(icon)).Attr("aria-hidden", "true")
 	link.Child(iconEl)
 
 	// Add title and caret
 	titleContainer := hb.P()
-	titleContainer.Child(hb.Span().HTML(item.Title))
+	titleContainer.Child(hb.Span().Text(title))
 
 	if hasChildren {
-		caret := hb.I().Class("right fas fa-angle-left")
+		caret := hb.I().Class("right fas fa-angle-left").Attr("aria-hidden", "true")
 		titleContainer.Child(caret)
 	}
 
@@ -191,7 +219,7 @@ func buildSidebarMenuItem(item types.MenuItem, index int) *hb.Tag {
 	if hasChildren {
 		childList := hb.Ul().Class("nav nav-treeview")
 		for _, child := range item.Children {
-			childItem := buildSidebarMenuItem(child, index+1)
+			childItem := buildSidebarMenuItem(dashboard, child, index+1)
 			childList.Child(childItem)
 		}
 		li.Child(childList)
@@ -1,6 +1,7 @@
 package adminlte
 
 import (
+	"github.com/dracory/dashboard/shared"
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
 )
@@ -23,7 +24,7 @@ func menuModal(dashboard types.DashboardInterface) *hb.Tag {
 	// Modal header
 	header := hb.Div().Class("modal-header")
 	header.Child(hb.H5().Class("modal-title").Text(dashboard.GetTitle()))
-	header.Child(hb.Button().Class("close").Attr("data-dismiss", "modal").HTML("&times;"))
+	header.Child(hb.Button().Class("close").Attr("data-dismiss", "modal").Attr("aria-label", dashboard.Translate(shared.MESSAGE_CLOSE)).HTML("&times;"))
 	modalContent.Child(header)
 
 	// Modal body with menu
@@ -0,0 +1,32 @@
+package adminlte_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestMenuUntitledItem(t *testing.T) {
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_ADMINLTE)
+	d.SetUser(types.User{FirstName: "Jane"})
+	d.SetLocale("de")
+	d.SetTranslator(dashboard.MessageCatalog{"de": {shared.MESSAGE_NOT_AVAILABLE: "k. A."}})
+	d.SetMenuMainItems([]types.MenuItem{
+		{URL: "/untitled"},
+		{Title: "Reports", Children: []types.MenuItem{{URL: "/reports/untitled"}}},
+	})
+
+	html := d.ToHTML()
+
+	if strings.Contains(html, "<span>n/a</span>") {
+		t.Error("expected the untitled items not to use the English fallback")
+	}
+
+	if count := strings.Count(html, "<span>k. A.</span>"); count < 2 {
+		t.Errorf("expected the untitled items to be translated, found %d", count)
+	}
+}
@@ -2,9 +2,13 @@ package adminlte
 
 import (
 	"fmt"
+	"strconv"
 
+	"github.com/dracory/dashboard/shared"
+	templatesshared "github.com/dracory/dashboard/templates/shared"
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
+	"github.com/samber/lo"
 )
 
 func topNavigation(dashboard types.DashboardInterface) *hb.Tag {
@@ -13,11 +17,18 @@ func topNavigation(dashboard types.DashboardInterface) *hb.Tag {
 	hasNavbarBackgroundColor := dashboard.GetNavbarBackgroundColor() != ""
 	hasNavbarTextColor := dashboard.GetNavbarTextColor() != ""
 
-	// Navbar with proper text color classes
-	navbar := hb.Nav().Class("main-header navbar navbar-expand navbar-white navbar-light")
+	config := dashboard.GetAdminLTEConfig()
+
+	// Navbar with the configured variant and proper text color classes
+	navbar := hb.Nav().
+		Class("main-header navbar").
+		Class(lo.Ternary(config.LayoutTopNav, "navbar-expand-md", "navbar-expand")).
+		Class(navbarVariantClass(config, dashboard.IsThemeDark())).
+		ClassIf(isTextSmall(config), "text-sm").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_TOOLBAR))
 
 	// Left navbar container
-	leftContainer := hb.Div().Class("container-fluid")
+	leftContainer := hb.Div().Class(lo.Ternary(config.LayoutTopNav, "container", "container-fluid"))
 	navbar.Child(leftContainer)
 
 	// Left navbar links
@@ -25,45 +36,81 @@ func topNavigation(dashboard types.DashboardInterface) *hb.Tag {
 	leftContainer.Child(leftNavbar)
 
 	// Navbar right icons
-	rightNavbar := hb.Ul().Class("navbar-nav ms-auto")
-	leftContainer.Child(hb.Div().Class("d-flex").Child(rightNavbar))
+	rightNavbar := hb.Ul().Class("navbar-nav ml-auto")
 
-	// Left navbar menu toggle
-	leftNavbar.Child(
-		hb.Li().Class("nav-item").Child(
-			hb.A().Class("nav-link").Attr("data-lte-toggle", "sidebar").Attr("href", "#").Child(
-				hb.I().Class("fas fa-bars"),
+	// Left navbar menu toggle, the top navigation layout has no sidebar
+	if !config.LayoutTopNav {
+		leftNavbar.Child(
+			hb.Li().Class("nav-item").Child(
+				hb.A().Class("nav-link").Data("widget", "pushmenu").Attr("href", "#").Role("button").
+					Attr("aria-label", dashboard.Translate(shared.MESSAGE_TOGGLE_SIDEBAR)).
+					Child(hb.I().Class("fas fa-bars").Attr("aria-hidden", "true")),
 			),
-		),
-	)
+		)
+	}
 
 	// Logo
 	logo := hb.Div()
 	switch {
 	case hasLogoRawHTML:
-		logo.Child(hb.Raw(dashboard.GetLogoRawHtml()))
+		logo.Child(hb.Raw(string(dashboard.GetLogoRawHtml())))
 	case hasLogoImage:
-		logo.Child(hb.Img(dashboard.GetLogoImageURL()).Style("max-height:35px;height:35px;width:auto;"))
+		logo.Child(hb.Img(dashboard.GetLogoImageURL()).
+			Alt(dashboard.Translate(shared.MESSAGE_HOME)).
+			Style("max-height:35px;height:35px;width:auto;"))
 	default:
 		logo.Child(hb.Div().Text(dashboard.GetTitle()))
 	}
 
 	leftNavbar.Child(
 		hb.Li().Class("nav-item d-none d-sm-inline-block").Child(
-			hb.A().Class("nav-link").Href("/").Child(logo),
+			hb.A().Class("nav-link").Href("/").
+				AttrIf(hasLogoRawHTML, "aria-label", dashboard.Translate(shared.MESSAGE_HOME)).
+				Child(logo),
 		),
 	)
 
+	// Environment badge
+	if badge := templatesshared.EnvironmentBadge(dashboard); badge != nil {
+		leftNavbar.Child(hb.Li().Class("nav-item d-flex align-items-center ml-2").Child(badge))
+	}
+
+	// Navbar start region
+	if region := templatesshared.Region(dashboard, shared.REGION_NAVBAR_START); region != nil {
+		leftNavbar.Child(hb.Li().Class("nav-item d-flex align-items-center ml-2").Child(region.Class("d-flex align-items-center")))
+	}
+
+	// Search box
+	if search := navbarSearch(dashboard); search != nil {
+		leftNavbar.Child(search)
+	}
+
+	// Main menu in the navbar, for the top navigation layout
+	if config.LayoutTopNav && dashboard.GetMenuType() != "modal" {
+		leftContainer.Child(navbarTopNavToggler(dashboard))
+		leftContainer.Child(navbarTopNavMenu(dashboard, dashboard.GetMenuMainItems()))
+	}
+
+	leftContainer.Child(hb.Div().Class("d-flex").Child(rightNavbar))
+
+	// Navbar end region
+	if region := templatesshared.Region(dashboard, shared.REGION_NAVBAR_END); region != nil {
+		rightNavbar.Child(hb.Li().Class("nav-item d-flex align-items-center mr-2").Child(region.Class("d-flex align-items-center")))
+	}
+
 	// User menu
 	if user := dashboard.GetUser(); user != nil {
 		navbarTextColor := dashboard.GetNavbarTextColor()
-		userMenu := navbarUserMenu(navbarTextColor, *user, dashboard.GetMenuUserItems())
+		avatar := templatesshared.UserAvatar(dashboard, *user, 32, "user-image")
+		menuAvatar := templatesshared.UserAvatar(dashboard, *user, 50, "mr-3")
+		userMenu := navbarUserMenu(navbarTextColor, dashboard.Translate(shared.MESSAGE_USER_MENU), *user, avatar, menuAvatar, dashboard.GetMenuUserItems(),
+			templatesshared.ImpersonationSignedInAs(dashboard), templatesshared.ImpersonationStopForm(dashboard, "dropdown-item"))
 		rightNavbar.Child(userMenu)
 	}
 
 	// Theme switcher
 	navbarTextColor := dashboard.GetNavbarTextColor()
-	themeSwitcher := navbarThemeSwitcher(navbarTextColor, "default", "/theme")
+	themeSwitcher := navbarThemeSwitcher(navbarTextColor, dashboard.Translate(shared.MESSAGE_THEME), dashboard.GetTheme(), dashboard.GetThemeHandlerUrl(), dashboard.GetThemesAvailable())
 	rightNavbar.Child(themeSwitcher)
 
 	// Add navbar background color if set
@@ -78,3 +125,97 @@ func topNavigation(dashboard types.DashboardInterface) *hb.Tag {
 
 	return navbar
 }
+
+// navbarTopNavToggler creates the button which expands the top navigation
+// menu on small screens
+func navbarTopNavToggler(dashboard types.DashboardInterface) *hb.Tag {
+	return hb.Button().
+		Class("navbar-toggler order-1").
+		Type("button").
+		Data("toggle", "collapse").
+		Data("target", "#navbarTopNavMenu").
+		Attr("aria-controls", "navbarTopNavMenu").
+		Attr("aria-expanded", "false").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_TOGGLE_NAVIGATION)).
+		Child(hb.Span().Class("navbar-toggler-icon"))
+}
+
+// navbarTopNavMenu creates the main menu of the top navigation layout,
+// items with children become dropdowns
+func navbarTopNavMenu(dashboard types.DashboardInterface, menuItems []types.MenuItem) *hb.Tag {
+	untitled := dashboard.Translate(shared.MESSAGE_NOT_AVAILABLE)
+	ul := hb.Ul().Class("navbar-nav")
+
+	for index, item := range menuItems {
+		li := hb.Li().Class("nav-item")
+		link := hb.A().Class("nav-link").Href(lo.Ternary(item.URL == "", "#", item.URL)).
+			ClassIf(item.IsActive, "active").
+			AttrIf(item.IsActive, "aria-current", "page")
+
+		if item.Icon != "" {
+			link.Child(hb.I().Class(string(item.Icon)+" mr-1").Attr("aria-hidden", "true"))
+		}
+
+		link.Child(hb.Span().Text(lo.Ternary(item.Title == "", untitled, item.Title)))
+
+		if len(item.Children) > 0 {
+			id := "navbarTopNavDropdown" + strconv.Itoa(index)
+			li.Class("dropdown")
+			link.ID(id).
+				Class("dropdown-toggle").
+				Data("toggle", "dropdown").
+				Attr("aria-haspopup", "true").
+				Attr("aria-expanded", "false")
+			li.Child(link)
+			li.Child(navbarTopNavDropdown(untitled, id, item.Children))
+		} else {
+			li.Child(link)
+		}
+
+		ul.Child(li)
+	}
+
+	return hb.Div().
+		Class("collapse navbar-collapse order-3").
+		ID("navbarTopNavMenu").
+		Child(ul)
+}
+
+// navbarTopNavDropdown creates a dropdown of the top navigation menu,
+// nested children become hover submenus
+func navbarTopNavDropdown(untitled, id string, menuItems []types.MenuItem) *hb.Tag {
+	dropdown := hb.Ul().
+		Class("dropdown-menu border-0 shadow").
+		Attr("aria-labelledby", id)
+
+	for index, item := range menuItems {
+		link := hb.A().Class("dropdown-item").Href(lo.Ternary(item.URL == "", "#", item.URL)).
+			ClassIf(item.IsActive, "active").
+			AttrIf(item.IsActive, "aria-current", "page")
+
+		if item.Icon != "" {
+			link.Child(hb.I().Class(string(item.Icon)+" mr-1").Attr("aria-hidden", "true"))
+		}
+
+		link.Child(hb.Span().Text(lo.Ternary(item.Title == "", untitled, item.Title)))
+
+		if len(item.Children) == 0 {
+			dropdown.Child(hb.Li().Child(link))
+			continue
+		}
+
+		childID := id + "_" + strconv.Itoa(index)
+		link.ID(childID).
+			Class("dropdown-toggle").
+			Data("toggle", "dropdown").
+			Attr("aria-haspopup", "true").
+			Attr("aria-expanded", "false")
+
+		dropdown.Child(hb.Li().
+			Class("dropdown-submenu dropdown-hover").
+			Child(link).
+			Child(navbarTopNavDropdown(untitled, childID, item.Children)))
+	}
+
+	return dropdown
+}
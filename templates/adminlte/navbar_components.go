@@ -1,177 +1,35 @@
 package adminlte
 
 import (
+	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
+	"github.com/samber/lo"
 )
 
-// navbarMessagesDropdown creates a messages dropdown menu
-func navbarMessagesDropdown(navbarTextColor string, messages []types.MenuItem) *hb.Tag {
-	// Only show first 4 messages in the dropdown
-	visibleMessages := messages
-	if len(visibleMessages) > 4 {
-		visibleMessages = visibleMessages[:4]
-	}
-
-	dropdown := hb.Li().Class("nav-item dropdown")
-	a := hb.A().Href("#").Class("nav-link").Data("toggle", "dropdown")
-
-	// Message icon with badge
-	icon := hb.I().Class("far fa-comments")
-	if navbarTextColor != "" {
-		icon.Style("color: " + navbarTextColor + " !important")
-	}
-	a.Child(icon)
-	a.Child(hb.Span().Class("badge badge-danger navbar-badge").HTML(strconv.Itoa(len(messages))))
-
-	// Dropdown menu
-	menu := hb.Div().Class("dropdown-menu dropdown-menu-lg dropdown-menu-right")
-
-	// Header
-	header := hb.Span().Class("dropdown-item dropdown-header").HTML(strconv.Itoa(len(messages)) + " Messages")
-	menu.Child(header)
-
-	// Messages
-	for _, msg := range visibleMessages {
-		menu.Child(createMessageItem(msg, navbarTextColor))
-	}
-
-	// Footer
-	footer := hb.A().Href("#").Class("dropdown-item dropdown-footer").HTML("See All Messages")
-	menu.Child(footer)
-
-	dropdown.Child(a)
-	dropdown.Child(menu)
-
-	return dropdown
-}
-
-// createMessageItem creates a single message item for the messages dropdown
-func createMessageItem(msg types.MenuItem, textColor string) *hb.Tag {
-	item := hb.Div().Class("dropdown-item")
-
-	// Message header
-	header := hb.Div().Class("media")
-
-	// User image
-	userImg := hb.Img("https://www.gravatar.com/avatar/00000000000000000000000000000000?d=mp&f=y")
-	userImg.Class("img-size-50 mr-3 img-circle")
-	header.Child(userImg)
-
-	mediaBody := hb.Div().Class("media-body")
-	mediaBody.Child(hb.H3().Class("dropdown-item-title").HTML(msg.Title))
-
-	// Only add icon if it's not empty
-	if msg.Icon != "" {
-		mediaBody.Child(hb.P().Class("text-sm text-muted").Child(hb.I().Class(msg.Icon + " mr-2")).HTML(msg.URL))
-	} else {
-		mediaBody.Child(hb.P().Class("text-sm text-muted").HTML(msg.URL))
-	}
-
-	header.Child(mediaBody)
-	item.Child(header)
-
-	// Make the entire item clickable if URL is provided
-	if msg.URL != "" {
-		link := hb.A().Href(msg.URL).Class("dropdown-item")
-		if msg.Target != "" {
-			link.Attr("target", msg.Target)
-		}
-		link.Child(item)
-		return link
-	}
-
-	return item
-}
-
-// navbarNotificationsDropdown creates a notifications dropdown menu
-func navbarNotificationsDropdown(navbarTextColor string, notifications []types.Alert) *hb.Tag {
-	// Only show first 5 notifications in the dropdown
-	visibleNotifications := notifications
-	if len(visibleNotifications) > 5 {
-		visibleNotifications = visibleNotifications[:5]
-	}
-
-	dropdown := hb.Li().Class("nav-item dropdown")
-	a := hb.A().Href("#").Class("nav-link").Data("toggle", "dropdown")
-
-	// Notification icon with badge
-	icon := hb.I().Class("far fa-bell")
-	if navbarTextColor != "" {
-		icon.Style("color: " + navbarTextColor + " !important")
-	}
-	a.Child(icon)
-	a.Child(hb.Span().Class("badge badge-warning navbar-badge").HTML(strconv.Itoa(len(notifications))))
-
-	// Dropdown menu
-	menu := hb.Div().Class("dropdown-menu dropdown-menu-lg dropdown-menu-right")
-
-	// Header
-	header := hb.Span().Class("dropdown-item dropdown-header").HTML(strconv.Itoa(len(notifications)) + " Notifications")
-	menu.Child(header)
-
-	// Notifications
-	for _, notif := range visibleNotifications {
-		menu.Child(createNotificationItem(notif, navbarTextColor))
-	}
-
-	// Footer
-	footer := hb.A().Href("#").Class("dropdown-item dropdown-footer").HTML("View All Notifications")
-	menu.Child(footer)
-
-	dropdown.Child(a)
-	dropdown.Child(menu)
-
-	return dropdown
-}
-
-// createNotificationItem creates a single notification item for the notifications dropdown
-func createNotificationItem(alert types.Alert, textColor string) *hb.Tag {
-	item := hb.Div().Class("dropdown-item")
-
-	// Notification icon based on alert type
-	iconClass := "fas fa-info-circle"
-	switch alert.Type {
-	case "success":
-		iconClass = "fas fa-check-circle"
-	case "danger":
-		iconClass = "fas fa-exclamation-circle"
-	case "warning":
-		iconClass = "fas fa-exclamation-triangle"
-	}
-
-	icon := hb.I().Class(iconClass + " mr-2")
-	if textColor != "" {
-		icon.Style("color: " + textColor + " !important")
-	}
-
-	// Notification content
-	content := hb.Div().Class("d-flex align-items-center")
-	content.Child(icon)
-	content.Child(hb.Div().HTML(alert.Message))
-
-	item.Child(content)
-	return item
-}
-
-// navbarUserMenu creates the user menu dropdown
-func navbarUserMenu(navbarTextColor string, user types.User, userMenuItems []types.MenuItem) *hb.Tag {
+// navbarUserMenu creates the user menu dropdown, the avatar is shown in the
+// toggle and the menu avatar in the user info section of the menu. While
+// impersonating, the user info names the real user and the menu ends with
+// the stop impersonating form.
+func navbarUserMenu(navbarTextColor, label string, user types.User, avatar, menuAvatar *hb.Tag, userMenuItems []types.MenuItem, signedInAs string, stopImpersonating *hb.Tag) *hb.Tag {
 	dropdown := hb.Li().Class("nav-item dropdown user-menu")
 
 	// User menu toggle
-	toggle := hb.A().Href("#").Class("nav-link").Data("toggle", "dropdown")
-
-	// User image
-	userImage := hb.Img("https://www.gravatar.com/avatar/00000000000000000000000000000000?d=mp&f=y")
-	userImage.Class("user-image img-circle elevation-2")
+	toggle := hb.A().Href("#").Class("nav-link").Data("toggle", "dropdown").
+		Role("button").
+		Attr("aria-label", label).
+		Attr("aria-haspopup", "true").
+		Attr("aria-expanded", "false")
 
-	toggle.Child(userImage)
+	// User avatar, the image, the Gravatar or the initials, with the status
+	toggle.Child(avatar)
 
 	// User name
-	if user.FirstName != "" {
-		toggle.Child(hb.Span().Class("d-none d-md-inline").HTML(user.FirstName))
+	if userName := user.Name(); userName != "" {
+		toggle.Child(hb.Span().Class("d-none d-md-inline").Text(userName))
 	}
 
 	dropdown.Child(toggle)
@@ -183,18 +41,26 @@ func navbarUserMenu(navbarTextColor string, user types.User, userMenuItems []typ
 	userInfo := hb.Div().Class("dropdown-item dropdown-header")
 	userInfo.Style("color: #495057 !important")
 
-	// User image in menu
-	userInfo.Child(userImage.Class("img-size-50 mr-3 img-circle"))
+	// User avatar in menu
+	userInfo.Child(menuAvatar)
 
 	// User details
 	userDetails := hb.Div()
-	userDetails.Child(hb.P().HTML(user.FirstName))
+	userDetails.Child(hb.P().Class("mb-0").Text(user.Name()))
+
+	if user.JobTitle != "" {
+		userDetails.Child(hb.Div().Class("text-muted").Style("font-size: 0.875em;").Text(user.JobTitle))
+	}
 
 	if user.Email != "" {
-		emailSpan := hb.Span().Class("text-muted").Style("font-size: 0.875em;").HTML(user.Email)
+		emailSpan := hb.Span().Class("text-muted").Style("font-size: 0.875em;").Text(user.Email)
 		userDetails.Child(emailSpan)
 	}
 
+	if signedInAs != "" {
+		userDetails.Child(hb.Div().Class("text-warning").Style("font-size: 0.875em;").Text(signedInAs))
+	}
+
 	userInfo.Child(userDetails)
 	dropdownMenu.Child(userInfo)
 
@@ -214,7 +80,7 @@ func navbarUserMenu(navbarTextColor string, user types.User, userMenuItems []typ
 
 			// Add icon if available
 			if item.Icon != "" {
-				icon := hb.I().Class(item.Icon + " mr-2")
+				icon := hb.I().Class(string(item.Icon) + " mr-2")
 				if navbarTextColor != "" {
 					icon.Style("color: " + navbarTextColor + " !important")
 				}
@@ -222,7 +88,7 @@ func navbarUserMenu(navbarTextColor string, user types.User, userMenuItems []typ
 			}
 
 			// Add title
-			link.Child(hb.Span().HTML(item.Title))
+			link.Child(hb.Span().Text(item.Title))
 
 			// Show sequence number as a badge if it's greater than 0
 			if item.Sequence > 0 {
@@ -235,63 +101,64 @@ func navbarUserMenu(navbarTextColor string, user types.User, userMenuItems []typ
 		}
 	}
 
+	// Stop impersonating
+	if stopImpersonating != nil {
+		dropdownMenu.Child(hb.Div().Class("dropdown-divider"))
+		dropdownMenu.Child(stopImpersonating)
+	}
+
 	dropdown.Child(dropdownMenu)
 
 	return dropdown
 }
 
 // navbarThemeSwitcher creates a theme switcher dropdown
-func navbarThemeSwitcher(navbarTextColor, currentTheme, themeHandlerUrl string) *hb.Tag {
+func navbarThemeSwitcher(navbarTextColor, title, currentTheme, themeHandlerUrl string, themes []types.Theme) *hb.Tag {
 	// Theme icon
 	iconMoon := hb.I().
 		Class("fas fa-moon").
+		Attr("aria-hidden", "true").
 		StyleIf(navbarTextColor != "", "color: "+navbarTextColor+" !important")
 
 	linkThemeSwitcher := hb.A().
 		Href("#").
 		Class("nav-link").
 		Data("toggle", "dropdown").
+		Role("button").
+		Attr("aria-label", title).
+		Attr("aria-haspopup", "true").
+		Attr("aria-expanded", "false").
 		Child(iconMoon)
 
 	// Dropdown menu
 	headerMenu := hb.H6().
 		Class("dropdown-header").
-		HTML("Theme")
+		Text(title)
 
 	menuDropdown := hb.Div().
 		Class("dropdown-menu dropdown-menu-right").
 		Child(headerMenu)
 
 	// Theme options
-	themes := []string{
-		"default",
-		"light",
-		"dark",
-	}
-
-	themeTitles := map[string]string{
-		"default": "Default",
-		"light":   "Light",
-		"dark":    "Dark",
-	}
-
-	themeIcons := map[string]string{
-		"default": "fas fa-adjust",
-		"light":   "fas fa-sun",
-		"dark":    "fas fa-moon",
-	}
+	themeIcons := ThemeIcons()
 
 	for _, theme := range themes {
-		link := hb.A().Href("#").Class("dropdown-item theme-switch")
-		link.Data("theme", theme)
+		// Link to the theme handler, which stores the theme server-side
+		themeUrl := lo.Ternary(strings.Contains(themeHandlerUrl, "?"),
+			themeHandlerUrl+"&theme="+url.QueryEscape(theme.ID),
+			themeHandlerUrl+"?theme="+url.QueryEscape(theme.ID))
+
+		link := hb.A().Href(themeUrl).Class("dropdown-item theme-switch")
+		link.Data("theme", theme.ID)
+		link.AttrIf(theme.ID == currentTheme, "aria-current", "true")
 
-		iconHTML := "<i class=\"" + themeIcons[theme] + " mr-2\"></i>"
+		iconHTML := "<i class=\"" + themeIcons[theme.ID] + " mr-2\" aria-hidden=\"true\"></i>"
 		link.Child(hb.Raw(iconHTML))
 
-		link.Child(hb.Span().HTML(themeTitles[theme]))
+		link.Child(hb.Span().Text(theme.Name))
 
-		if theme == currentTheme {
-			checkIcon := "<i class=\"fas fa-check float-right mt-1\"></i>"
+		if theme.ID == currentTheme {
+			checkIcon := "<i class=\"fas fa-check float-right mt-1\" aria-hidden=\"true\"></i>"
 			link.Child(hb.Raw(checkIcon))
 		}
 
@@ -0,0 +1,53 @@
+package adminlte
+
+import (
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// navbarSearch creates the search box of the navbar, or nil when
+// no search handler is set
+func navbarSearch(dashboard types.DashboardInterface) *hb.Tag {
+	if dashboard.GetSearchHandlerUrl() == "" {
+		return nil
+	}
+
+	input := hb.NewInput().
+		Type("search").
+		Name("q").
+		Class("form-control form-control-navbar").
+		Placeholder(dashboard.Translate(shared.MESSAGE_SEARCH_PLACEHOLDER)).
+		Attr("autocomplete", "off").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_SEARCH)).
+		Attr("aria-expanded", "false").
+		Attr("aria-controls", "navbarSearchResults")
+
+	button := hb.NewButton().
+		Class("btn btn-navbar").
+		Type("submit").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_SEARCH)).
+		Child(hb.NewI().Class("fas fa-search"))
+
+	results := hb.NewDiv().
+		ID("navbarSearchResults").
+		Class("dropdown-menu w-100").
+		Style("max-height:400px;overflow-y:auto;").
+		Attr("role", "listbox").
+		Data("search-results", "true")
+
+	form := hb.NewForm().
+		Class("form-inline ml-3 position-relative").
+		Attr("role", "search").
+		Attr("action", dashboard.GetSearchHandlerUrl()).
+		Attr("method", "get").
+		Data("dashboard-search", "true").
+		Child(hb.NewDiv().Class("input-group input-group-sm").
+			Child(input).
+			Child(hb.NewDiv().Class("input-group-append").Child(button))).
+		Child(results)
+
+	return hb.NewLI().
+		Class("nav-item d-none d-md-block").
+		Child(form)
+}
@@ -0,0 +1,107 @@
+package adminlte
+
+import (
+	"slices"
+
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// accentColors are the accent colors supported by AdminLTE skins
+var accentColors = []string{
+	"primary", "secondary", "info", "success", "warning", "danger",
+	"indigo", "lightblue", "navy", "purple", "fuchsia", "pink",
+	"maroon", "orange", "lime", "teal", "olive",
+}
+
+// lightAccentColors are the accent colors which need dark navbar text
+var lightAccentColors = []string{"warning", "orange", "lime"}
+
+// sidebarSkinClass returns the skin class of the main sidebar,
+// e.g. sidebar-dark-primary, unknown values fall back to the defaults
+func sidebarSkinClass(config types.AdminLTEConfig) string {
+	skin := shared.TEMPLATE_ADMINLTE_SIDEBAR_SKIN_DARK
+	if config.SidebarSkin == shared.TEMPLATE_ADMINLTE_SIDEBAR_SKIN_LIGHT {
+		skin = shared.TEMPLATE_ADMINLTE_SIDEBAR_SKIN_LIGHT
+	}
+
+	accent := "primary"
+	if slices.Contains(accentColors, config.SidebarAccent) {
+		accent = config.SidebarAccent
+	}
+
+	return "sidebar-" + skin + "-" + accent
+}
+
+// navbarVariantClass returns the color classes of the main header,
+// in dark mode the default white navbar is rendered dark
+func navbarVariantClass(config types.AdminLTEConfig, isDark bool) string {
+	variant := config.NavbarVariant
+
+	switch {
+	case variant == "" || variant == "white":
+		if isDark {
+			return "navbar-dark"
+		}
+		return "navbar-white navbar-light"
+	case variant == "light":
+		return "navbar-light"
+	case variant == "dark":
+		return "navbar-dark"
+	case slices.Contains(lightAccentColors, variant):
+		return "navbar-" + variant + " navbar-light"
+	case slices.Contains(accentColors, variant):
+		return "navbar-" + variant + " navbar-dark"
+	default:
+		return "navbar-white navbar-light"
+	}
+}
+
+// isTextSmall returns whether the small text variant is enabled
+func isTextSmall(config types.AdminLTEConfig) bool {
+	return config.TextSize == shared.TEMPLATE_ADMINLTE_TEXT_SIZE_SMALL
+}
+
+// bodyClasses returns the classes of the body tag, rendered server-side
+// so that the page is painted with the final skin and layout
+func bodyClasses(dashboard types.DashboardInterface) []string {
+	config := dashboard.GetAdminLTEConfig()
+	classes := []string{}
+
+	if config.LayoutTopNav {
+		classes = append(classes, "layout-top-nav")
+	} else {
+		classes = append(classes, "sidebar-mini")
+	}
+
+	if dashboard.IsThemeDark() {
+		classes = append(classes, "dark-mode")
+	}
+
+	if config.FixedSidebar && !config.LayoutTopNav {
+		classes = append(classes, "layout-fixed")
+	}
+
+	if config.FixedHeader {
+		classes = append(classes, "layout-navbar-fixed")
+	}
+
+	if config.FixedFooter {
+		classes = append(classes, "layout-footer-fixed")
+	}
+
+	if config.LayoutBoxed {
+		classes = append(classes, "layout-boxed")
+	}
+
+	if isTextSmall(config) {
+		classes = append(classes, "text-sm")
+	}
+
+	// Restore the stored sidebar state before the first paint
+	if dashboard.GetSidebarCollapsed() && !config.LayoutTopNav {
+		classes = append(classes, "sidebar-collapse")
+	}
+
+	return classes
+}
@@ -0,0 +1,150 @@
+package adminlte_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func renderSkin(theme string, config types.AdminLTEConfig) string {
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_ADMINLTE)
+	d.SetAdminLTEConfig(config)
+	d.SetTheme(theme)
+	d.SetTitle("Skin Test")
+	d.SetFooter(types.Footer{Copyright: "© Acme"})
+	d.SetUser(types.User{FirstName: "Jane", LastName: "Doe"})
+	d.SetMenuMainItems([]types.MenuItem{
+		{Title: "Home", URL: "/"},
+		{Title: "Reports", Children: []types.MenuItem{
+			{Title: "Sales", URL: "/reports/sales"},
+		}},
+	})
+	return d.ToHTML()
+}
+
+func bodyTag(html string) string {
+	return regexp.MustCompile(`<body[^>]*>`).FindString(html)
+}
+
+func TestSkins(t *testing.T) {
+	tests := []struct {
+		name       string
+		theme      string
+		config     types.AdminLTEConfig
+		contains   []string
+		notContain []string
+		bodyClass  string
+	}{
+		{
+			name:  "defaults",
+			theme: "light",
+			contains: []string{
+				`main-sidebar sidebar-dark-primary elevation-4`,
+				`main-header navbar navbar-expand navbar-white navbar-light`,
+				`data-widget="pushmenu"`,
+			},
+			notContain: []string{`navbarTopNavMenu`},
+			bodyClass:  `<body class="sidebar-mini">`,
+		},
+		{
+			name:  "light skin with accent",
+			theme: "light",
+			config: types.AdminLTEConfig{
+				SidebarSkin:   shared.TEMPLATE_ADMINLTE_SIDEBAR_SKIN_LIGHT,
+				SidebarAccent: "teal",
+				NavbarVariant: "primary",
+			},
+			contains: []string{
+				`main-sidebar sidebar-light-teal elevation-4`,
+				`navbar-expand navbar-primary navbar-dark`,
+			},
+			bodyClass: `<body class="sidebar-mini">`,
+		},
+		{
+			name:  "unknown values fall back",
+			theme: "light",
+			config: types.AdminLTEConfig{
+				SidebarSkin:   "purple",
+				SidebarAccent: "rainbow",
+				NavbarVariant: "rainbow",
+			},
+			contains: []string{
+				`sidebar-dark-primary`,
+				`navbar-white navbar-light`,
+			},
+		},
+		{
+			name:      "dark mode",
+			theme:     "dark",
+			contains:  []string{`navbar-expand navbar-dark`},
+			bodyClass: `<body class="sidebar-mini dark-mode">`,
+		},
+		{
+			name:  "fixed, boxed and small text",
+			theme: "light",
+			config: types.AdminLTEConfig{
+				FixedHeader:  true,
+				FixedSidebar: true,
+				FixedFooter:  true,
+				LayoutBoxed:  true,
+				TextSize:     shared.TEMPLATE_ADMINLTE_TEXT_SIZE_SMALL,
+			},
+			contains: []string{
+				`<footer class="main-footer text-sm">`,
+				`brand-link text-sm`,
+			},
+			bodyClass: `<body class="sidebar-mini layout-fixed layout-navbar-fixed layout-footer-fixed layout-boxed text-sm">`,
+		},
+		{
+			name:   "top navigation",
+			theme:  "light",
+			config: types.AdminLTEConfig{LayoutTopNav: true, FixedSidebar: true},
+			contains: []string{
+				`navbar-expand-md`,
+				`<div class="collapse navbar-collapse order-3" id="navbarTopNavMenu">`,
+				`<ul aria-labelledby="navbarTopNavDropdown1" class="dropdown-menu border-0 shadow"><li><a class="dropdown-item" href="/reports/sales">`,
+			},
+			notContain: []string{`main-sidebar`, `data-widget="pushmenu"`},
+			bodyClass:  `<body class="layout-top-nav">`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := renderSkin(tt.theme, tt.config)
+
+			for _, s := range tt.contains {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+
+			for _, s := range tt.notContain {
+				if strings.Contains(html, s) {
+					t.Errorf("expected HTML not to contain %q", s)
+				}
+			}
+
+			if tt.bodyClass != "" && bodyTag(html) != tt.bodyClass {
+				t.Errorf("expected body tag %q, got %q", tt.bodyClass, bodyTag(html))
+			}
+		})
+	}
+}
+
+func TestThemeNotAppliedByScript(t *testing.T) {
+	html := renderSkin("dark", types.AdminLTEConfig{})
+
+	if strings.Contains(html, "adminlte-theme") {
+		t.Error("expected the theme not to be restored from a client-side cookie")
+	}
+
+	if !strings.Contains(bodyTag(html), "dark-mode") {
+		t.Error("expected dark mode to be rendered server-side")
+	}
+}
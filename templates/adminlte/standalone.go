@@ -0,0 +1,46 @@
+package adminlte
+
+import (
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// standalonePage adds the auth or error layout to the webpage, the AdminLTE
+// login box: the logo, a centred card with the content and the footer links
+func standalonePage(webpage *hb.HtmlWebpage, dashboard types.DashboardInterface) string {
+	webpage.Body().Class("hold-transition login-page")
+	if dashboard.IsThemeDark() {
+		webpage.Body().Class("dark-mode")
+	}
+
+	box := shared.MainContent().Class("login-box")
+
+	if logo := shared.AuthLogo(dashboard); logo != nil {
+		box.Child(hb.Div().Class("login-logo").Child(logo))
+	}
+
+	box.Child(hb.Div().Class("card").
+		Child(hb.Div().Class("card-body login-card-body").Children(shared.AuthContent(dashboard))))
+
+	if footer := shared.AuthFooter(dashboard, "text-muted"); footer != nil {
+		box.Child(footer)
+	}
+
+	webpage.Body().Child(shared.SkipLink(dashboard, "sr-only sr-only-focusable"))
+
+	// Add the environment ribbon
+	if ribbon := shared.EnvironmentRibbon(dashboard); ribbon != nil {
+		webpage.Body().Child(ribbon)
+	}
+
+	webpage.Body().Child(box)
+
+	// Add the body end region
+	if region := shared.Region(dashboard, dashboardshared.REGION_BODY_END); region != nil {
+		webpage.Body().Child(region)
+	}
+
+	return webpage.ToHTML()
+}
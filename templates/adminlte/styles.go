@@ -4,3 +4,103 @@ package adminlte
 func templateStyle() string {
 	return ``
 }
+
+// rtlStyle returns the CSS mirroring the AdminLTE layout and the Bootstrap 4
+// directional utilities for right-to-left pages. Neither AdminLTE 3 nor
+// Bootstrap 4 ship a right-to-left build, so the sidebar is moved to the
+// right and the left and right margins, paddings and floats are swapped.
+func rtlStyle() string {
+	return `
+[dir="rtl"] .main-sidebar,
+[dir="rtl"] .main-sidebar::before {
+	left: auto;
+	right: 0;
+	transition: margin-right .3s ease-in-out, width .3s ease-in-out;
+}
+@media (min-width: 768px) {
+	[dir="rtl"] body:not(.layout-top-nav) .content-wrapper,
+	[dir="rtl"] body:not(.layout-top-nav) .main-header,
+	[dir="rtl"] body:not(.layout-top-nav) .main-footer {
+		margin-left: 0 !important;
+		margin-right: 250px;
+		transition: margin-right .3s ease-in-out;
+	}
+	[dir="rtl"] body.sidebar-collapse:not(.layout-top-nav) .content-wrapper,
+	[dir="rtl"] body.sidebar-collapse:not(.layout-top-nav) .main-header,
+	[dir="rtl"] body.sidebar-collapse:not(.layout-top-nav) .main-footer {
+		margin-right: 0;
+	}
+	[dir="rtl"] body.sidebar-collapse .main-sidebar,
+	[dir="rtl"] body.sidebar-collapse .main-sidebar::before {
+		margin-left: 0 !important;
+		margin-right: -250px;
+	}
+}
+@media (min-width: 992px) {
+	[dir="rtl"] body.sidebar-mini.sidebar-collapse .content-wrapper,
+	[dir="rtl"] body.sidebar-mini.sidebar-collapse .main-header,
+	[dir="rtl"] body.sidebar-mini.sidebar-collapse .main-footer {
+		margin-right: 4.6rem !important;
+	}
+	[dir="rtl"] body.sidebar-mini.sidebar-collapse .main-sidebar,
+	[dir="rtl"] body.sidebar-mini.sidebar-collapse .main-sidebar::before {
+		margin-right: 0;
+	}
+}
+@media (max-width: 767.98px) {
+	[dir="rtl"] .main-sidebar,
+	[dir="rtl"] .main-sidebar::before {
+		margin-left: 0 !important;
+		margin-right: -250px;
+	}
+	[dir="rtl"] body.sidebar-open .main-sidebar,
+	[dir="rtl"] body.sidebar-open .main-sidebar::before {
+		margin-right: 0;
+	}
+}
+
+[dir="rtl"] .brand-link .brand-image {
+	float: right;
+	margin-left: .5rem;
+	margin-right: .8rem;
+}
+[dir="rtl"] .nav-sidebar .nav-link > .right,
+[dir="rtl"] .nav-sidebar .nav-link > p > .right {
+	left: 1rem;
+	right: auto;
+	transform: rotate(180deg);
+}
+[dir="rtl"] .nav-sidebar .menu-open > .nav-link > .right,
+[dir="rtl"] .nav-sidebar .menu-open > .nav-link > p > .right {
+	transform: rotate(-90deg);
+}
+[dir="rtl"] .nav-sidebar > .nav-item .nav-icon {
+	margin-left: .2rem;
+	margin-right: .05rem;
+}
+[dir="rtl"] .breadcrumb-item + .breadcrumb-item {
+	padding-left: 0;
+	padding-right: .5rem;
+}
+[dir="rtl"] .breadcrumb-item + .breadcrumb-item::before {
+	float: right;
+	padding-left: .5rem;
+	padding-right: 0;
+}
+
+[dir="rtl"] .float-right { float: left !important; }
+[dir="rtl"] .float-left { float: right !important; }
+[dir="rtl"] .text-right { text-align: left !important; }
+[dir="rtl"] .text-left { text-align: right !important; }
+[dir="rtl"] .ml-auto { margin-left: 0 !important; margin-right: auto !important; }
+[dir="rtl"] .mr-auto { margin-right: 0 !important; margin-left: auto !important; }
+[dir="rtl"] .mr-1 { margin-right: 0 !important; margin-left: .25rem !important; }
+[dir="rtl"] .mr-2 { margin-right: 0 !important; margin-left: .5rem !important; }
+[dir="rtl"] .mr-3 { margin-right: 0 !important; margin-left: 1rem !important; }
+[dir="rtl"] .ml-1 { margin-left: 0 !important; margin-right: .25rem !important; }
+[dir="rtl"] .ml-2 { margin-left: 0 !important; margin-right: .5rem !important; }
+[dir="rtl"] .ml-3 { margin-left: 0 !important; margin-right: 1rem !important; }
+[dir="rtl"] .dropdown-menu-right { left: 0; right: auto; }
+[dir="rtl"] .dropdown-menu-left { left: auto; right: 0; }
+`
+}
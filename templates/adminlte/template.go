@@ -1,6 +1,7 @@
 package adminlte
 
 import (
+	dashboardshared "github.com/dracory/dashboard/shared"
 	"github.com/dracory/dashboard/templates/shared"
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
@@ -15,8 +16,14 @@ var _ types.TemplateInterface = (*Template)(nil)
 
 // layout generates the main layout structure for the dashboard
 func (t *Template) layout(dashboard types.DashboardInterface) *hb.Tag {
-	// Main content wrapper
-	contentWrapper := hb.Div().Class("content-wrapper")
+	// Main content wrapper, the main landmark targeted by the skip link
+	contentWrapper := shared.MainContent().Class("content-wrapper")
+
+	// Impersonation banner, at the top of the content wrapper so the fixed
+	// navbar and sidebar layouts do not cover it
+	if banner := shared.ImpersonationBanner(dashboard); banner != nil {
+		contentWrapper.Child(banner)
+	}
 
 	// Content header
 	contentHeader := hb.Div().Class("content-header")
@@ -27,20 +34,27 @@ func (t *Template) layout(dashboard types.DashboardInterface) *hb.Tag {
 
 	// Left side (page title)
 	colSm6Left := hb.Div().Class("col-sm-6")
-	colSm6Left.Child(hb.H1().Class("m-0").HTML(dashboard.GetTitle()))
+	colSm6Left.Child(hb.H1().Class("m-0").Text(dashboard.GetTitle()))
 	row.Child(colSm6Left)
 
 	// Right side (breadcrumb)
 	colSm6Right := hb.Div().Class("col-sm-6")
 	breadcrumb := hb.Ol().Class("breadcrumb float-sm-right")
+	breadcrumbNav := hb.Nav().Attr("aria-label", dashboard.Translate(dashboardshared.MESSAGE_BREADCRUMB)).Child(breadcrumb)
 
-	homeLink := hb.Hyperlink().Href("/").HTML("Home")
+	homeLink := hb.Hyperlink().Href("/").Text(dashboard.Translate(dashboardshared.MESSAGE_HOME))
 	breadcrumb.Child(hb.Li().Class("breadcrumb-item").Child(homeLink))
 
-	dashboardItem := hb.Li().Class("breadcrumb-item active").HTML("Dashboard")
+	dashboardItem := hb.Li().Class("breadcrumb-item active").Attr("aria-current", "page").Text(dashboard.Translate(dashboardshared.MESSAGE_DASHBOARD))
 	breadcrumb.Child(dashboardItem)
 
-	colSm6Right.Child(breadcrumb)
+	colSm6Right.Child(breadcrumbNav)
+
+	// Page header end region, next to the breadcrumb
+	if region := shared.Region(dashboard, dashboardshared.REGION_PAGE_HEADER_END); region != nil {
+		colSm6Right.Child(region.Class("float-sm-right d-flex align-items-center mr-3"))
+	}
+
 	row.Child(colSm6Right)
 
 	containerFluid.Child(row)
@@ -50,7 +64,16 @@ func (t *Template) layout(dashboard types.DashboardInterface) *hb.Tag {
 	// Main content
 	contentSection := hb.Section().Class("content")
 	contentContainer := hb.Div().Class("container-fluid")
-	contentContainer.Child(hb.Raw(dashboard.GetContent()))
+	if region := shared.Region(dashboard, dashboardshared.REGION_CONTENT_BEFORE); region != nil {
+		contentContainer.Child(region)
+	}
+	if widgets := shared.Widgets(dashboard); widgets != nil {
+		contentContainer.Child(widgets)
+	}
+	contentContainer.Child(shared.FragmentPlaceholder(dashboard, shared.FRAGMENT_CONTENT))
+	if region := shared.Region(dashboard, dashboardshared.REGION_CONTENT_AFTER); region != nil {
+		contentContainer.Child(region)
+	}
 	contentSection.Child(contentContainer)
 	contentWrapper.Child(contentSection)
 
@@ -78,8 +101,38 @@ func (t *Template) getStylesAndScripts(dashboard types.DashboardInterface) (
 		scripts = append(scripts, script)
 	}
 
+	// Add sidebar state JavaScript
+	if script := sidebarStateScript(dashboard.GetSidebarHandlerUrl()); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add search JavaScript
+	if script := shared.SearchScript(dashboard.GetSearchHandlerUrl(), dashboard.Translate(dashboardshared.MESSAGE_SEARCH_NO_RESULTS)); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add the favicon tint of the environment
+	if script := shared.EnvironmentFaviconScript(dashboard); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add the session timeout JavaScript
+	if script := shared.SessionTimeoutScript(dashboard); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add command palette JavaScript
+	if script := shared.CommandPaletteScript(dashboard); script != "" {
+		scripts = append(scripts, script)
+	}
+
 	// AdminLTE CSS
-	styleURLs = append(styleURLs, "https://cdn.jsdelivr.net/npm/admin-lte@3.2.0/dist/css/adminlte.min.css")
+	styleURLs = append(styleURLs, stylesheetURL)
+
+	// AdminLTE has no right-to-left build, mirror its layout instead
+	if dashboard.IsRTL() {
+		styles = append(styles, rtlStyle())
+	}
 	// Font Awesome
 	styleURLs = append(styleURLs, "https://cdnjs.cloudflare.com/ajax/libs/font-awesome/6.4.0/css/all.min.css")
 	// Google Font: Source Sans Pro
@@ -92,11 +145,14 @@ func (t *Template) getStylesAndScripts(dashboard types.DashboardInterface) (
 	scriptURLs = append(scriptURLs, "https://cdn.jsdelivr.net/npm/overlayscrollbars@1.13.1/js/jquery.overlayScrollbars.min.js")
 	// AdminLTE JS
 	scriptURLs = append(scriptURLs, "https://cdn.jsdelivr.net/npm/admin-lte@3.2.0/dist/js/adminlte.min.js")
-	// Initialize AdminLTE with default options
-	scripts = append(scripts, "$(document).ready(function() { $('body').addClass('sidebar-mini'); });")
 
 	styles = append(styles, "@import url('https://fonts.googleapis.com/css2?family=Inter:wght@300;400;500;600;700&display=swap');")
 
+	// Add brand theme CSS custom properties, before the custom styles
+	if brand, ok := shared.BrandThemeActive(dashboard); ok {
+		styles = append(styles, brandThemeStyle(brand))
+	}
+
 	styleURLs = append(styleURLs, dashboard.GetStyleURLs()...)
 	scriptURLs = append(scriptURLs, dashboard.GetScriptURLs()...)
 
@@ -118,8 +174,10 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 	// Create a new webpage
 	webpage := hb.Webpage()
 
-	// Set the page title
-	webpage.SetTitle(dashboard.GetTitle())
+	// Set the page title and language
+	webpage.SetTitle(shared.EnvironmentTitle(dashboard))
+	webpage.SetLanguage(dashboard.GetLocale())
+	webpage.Attr("dir", dashboard.GetDirection())
 
 	// Add favicon
 	webpage.SetFavicon(favicon)
@@ -152,26 +210,20 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 		}
 	}
 
-	// Apply theme classes to body
-	theme := dashboard.GetTheme()
-	body := webpage.Body()
-
-	// Add dark mode class if theme is dark
-	if theme == ThemeDark {
-		body.Class("dark-mode")
+	// The auth and error page layouts replace the navigation of the dashboard
+	if shared.IsStandaloneLayout(dashboard) {
+		return standalonePage(webpage, dashboard)
 	}
 
-	// Apply navbar and sidebar theming
-	switch theme {
-	case ThemeDark:
-		body.Class("sidebar-dark-primary")
-	case "blue":
-		body.Class("sidebar-light-primary")
-	default:
-		// Default to light theme with primary color
-		body.Class("sidebar-light-primary")
+	// Apply the skin and layout classes to the body, so the page
+	// is painted with the final look without waiting for JavaScript
+	body := webpage.Body()
+	for _, class := range bodyClasses(dashboard) {
+		body.Class(class)
 	}
 
+	config := dashboard.GetAdminLTEConfig()
+
 	// Create main wrapper
 	wrapper := hb.Div().Class("wrapper")
 
@@ -181,8 +233,9 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 		wrapper.Child(navbar)
 	}
 
-	// Add sidebar if not in modal mode
-	if dashboard.GetMenuType() != "modal" {
+	// Add sidebar if not in modal mode, the top navigation layout
+	// renders the main menu in the navbar instead
+	if dashboard.GetMenuType() != "modal" && !config.LayoutTopNav {
 		sidebar := menuOffcanvas(dashboard)
 		if sidebar != nil {
 			wrapper.Child(sidebar)
@@ -192,27 +245,19 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 	// Add content wrapper with proper AdminLTE structure
 	wrapper.Child(t.layout(dashboard))
 
-	// Add footer
-	footer := hb.NewFooter().
-		Class("main-footer").
-		Child(hb.NewDiv().
-			Class("float-right d-none d-sm-block").
-			Child(
-				hb.NewDiv().Style("font-size: 85%;").Text("AdminLTE v3.2.0"),
-			)).
-		Child(hb.NewStrong().
-			Text("Copyright &copy; 2023").
-			Child(
-				hb.NewA().Href("#").Text("AdminLTE.io"),
-			).Text(". All rights reserved."))
-
-	wrapper.Child(footer)
-
-	// Add control sidebar
-	controlSidebar := hb.NewAside().Class("control-sidebar control-sidebar-dark")
-	wrapper.Child(controlSidebar)
-
-	// Add main wrapper to body
+	// Add footer if set
+	if footer := footer(dashboard); footer != nil {
+		wrapper.Child(footer)
+	}
+
+	// Add the skip link and the main wrapper to body
+	webpage.Body().Child(shared.SkipLink(dashboard, "sr-only sr-only-focusable"))
+
+	// Add the environment ribbon, in the corner of the page
+	if ribbon := shared.EnvironmentRibbon(dashboard); ribbon != nil {
+		webpage.Body().Child(ribbon)
+	}
+
 	webpage.Body().Child(wrapper)
 
 	// Add modal menu if needed
@@ -223,6 +268,21 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 		}
 	}
 
+	// Add the command palette if enabled
+	if modal := commandPaletteModal(dashboard); modal != nil {
+		webpage.Body().Child(modal)
+	}
+
+	// Add the session timeout modal if the session expiry is set
+	if modal := shared.SessionTimeoutModal(dashboard, ""); modal != nil {
+		webpage.Body().Child(modal)
+	}
+
+	// Add the body end region, after the layout and the modals
+	if region := shared.Region(dashboard, dashboardshared.REGION_BODY_END); region != nil {
+		webpage.Body().Child(region)
+	}
+
 	// Generate the final HTML
 	return webpage.ToHTML()
 }
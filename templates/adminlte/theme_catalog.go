@@ -0,0 +1,36 @@
+package adminlte
+
+import "github.com/dracory/dashboard/types"
+
+// Ensure Template implements the ThemeCatalogInterface
+var _ types.ThemeCatalogInterface = (*Template)(nil)
+
+// stylesheetURL is the AdminLTE stylesheet, shared by all themes
+const stylesheetURL = "https://cdn.jsdelivr.net/npm/admin-lte@3.2.0/dist/css/adminlte.min.css"
+
+// themeOrder is the order in which the themes are listed
+var themeOrder = []string{ThemeDefault, ThemeLight, ThemeDark}
+
+// Themes returns the themes supported by AdminLTE
+func (t *Template) Themes() []types.Theme {
+	names := ThemeNames()
+	colors := ThemeColors()
+
+	themes := make([]types.Theme, 0, len(themeOrder))
+	for _, id := range themeOrder {
+		themes = append(themes, types.Theme{
+			ID:            id,
+			Name:          names[id],
+			IsDark:        id == ThemeDark,
+			PreviewColors: []string{colors[id]},
+			StylesheetURL: stylesheetURL,
+		})
+	}
+
+	return themes
+}
+
+// ThemeDefault returns the ID of the default AdminLTE theme
+func (t *Template) ThemeDefault() string {
+	return ThemeDefault
+}
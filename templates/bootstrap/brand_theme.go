@@ -0,0 +1,69 @@
+package bootstrap
+
+import (
+	"github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// brandThemeStyle converts a brand theme to Bootstrap 5.3 CSS custom properties
+func brandThemeStyle(brand types.BrandTheme) string {
+	root := [][2]string{}
+	buttons := ""
+
+	for _, color := range shared.BrandThemeColors(brand) {
+		name, value := color[0], color[1]
+		root = append(root, [2]string{"--bs-" + name, value})
+		if rgb, ok := shared.HexToRGB(value); ok {
+			root = append(root, [2]string{"--bs-" + name + "-rgb", rgb})
+		}
+
+		// Button colors are compiled into the button classes, so
+		// they have to be overridden separately
+		darker := "color-mix(in srgb, " + value + " 85%, #000)"
+		buttons += shared.CSSRule(".btn-"+name, [][2]string{
+			{"--bs-btn-bg", value},
+			{"--bs-btn-border-color", value},
+			{"--bs-btn-hover-bg", darker},
+			{"--bs-btn-hover-border-color", darker},
+			{"--bs-btn-active-bg", darker},
+			{"--bs-btn-active-border-color", darker},
+			{"--bs-btn-disabled-bg", value},
+			{"--bs-btn-disabled-border-color", value},
+		})
+		buttons += shared.CSSRule(".btn-outline-"+name, [][2]string{
+			{"--bs-btn-color", value},
+			{"--bs-btn-border-color", value},
+			{"--bs-btn-hover-bg", value},
+			{"--bs-btn-hover-border-color", value},
+			{"--bs-btn-active-bg", value},
+			{"--bs-btn-active-border-color", value},
+		})
+	}
+
+	if brand.PrimaryColor != "" {
+		root = append(root, [2]string{"--bs-link-color", brand.PrimaryColor})
+		root = append(root, [2]string{"--bs-link-hover-color", "color-mix(in srgb, " + brand.PrimaryColor + " 80%, #000)"})
+		if rgb, ok := shared.HexToRGB(brand.PrimaryColor); ok {
+			root = append(root, [2]string{"--bs-link-color-rgb", rgb})
+		}
+	}
+
+	root = append(root,
+		[2]string{"--bs-body-font-family", brand.FontFamily},
+		[2]string{"--bs-border-radius", brand.BorderRadius},
+	)
+
+	style := shared.CSSRule(":root, [data-bs-theme]", root) + buttons
+
+	if brand.SpacingScale > 0 {
+		style += shared.CSSRule(".container, .container-fluid", [][2]string{
+			{"--bs-gutter-x", shared.Spacing(1.5, brand.SpacingScale)},
+		})
+		style += shared.CSSRule(".card", [][2]string{
+			{"--bs-card-spacer-y", shared.Spacing(1, brand.SpacingScale)},
+			{"--bs-card-spacer-x", shared.Spacing(1, brand.SpacingScale)},
+		})
+	}
+
+	return style
+}
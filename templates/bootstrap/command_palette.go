@@ -0,0 +1,46 @@
+package bootstrap
+
+import (
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// commandPaletteModal creates the modal of the command palette, or nil
+// when the command palette is disabled
+func commandPaletteModal(dashboard types.DashboardInterface) *hb.Tag {
+	if !dashboard.GetCommandPaletteEnabled() {
+		return nil
+	}
+
+	input := hb.Input().
+		Type("search").
+		Class("form-control form-control-lg").
+		Placeholder(dashboard.Translate(shared.MESSAGE_COMMAND_PLACEHOLDER)).
+		Attr("autocomplete", "off").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_COMMAND)).
+		Attr("aria-controls", "CommandPaletteResults").
+		Data("command-palette-input", "true")
+
+	results := hb.Div().
+		ID("CommandPaletteResults").
+		Class("list-group list-group-flush").
+		Role("listbox").
+		Data("command-palette-results", "true")
+
+	content := hb.Div().Class("modal-content").
+		Child(hb.Div().Class("modal-header").
+			Child(hb.H5().ID("CommandPaletteLabel").Class("visually-hidden").Text(dashboard.Translate(shared.MESSAGE_COMMAND_PALETTE))).
+			Child(input)).
+		Child(hb.Div().Class("modal-body p-0").Child(results)).
+		Child(hb.Div().Class("modal-footer small text-body-secondary").
+			Text(dashboard.Translate(shared.MESSAGE_COMMAND_HELP)))
+
+	return hb.Div().
+		ID("CommandPalette").
+		Class("modal fade").
+		Attr("tabindex", "-1").
+		Attr("aria-labelledby", "CommandPaletteLabel").
+		Attr("aria-hidden", "true").
+		Child(hb.Div().Class("modal-dialog modal-dialog-scrollable modal-lg").Child(content))
+}
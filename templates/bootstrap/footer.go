@@ -0,0 +1,61 @@
+package bootstrap
+
+import (
+	"github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// footer generates the page footer, or nil when the footer is not set
+func footer(dashboard types.DashboardInterface) *hb.Tag {
+	model := dashboard.GetFooter()
+	if model == nil {
+		return nil
+	}
+
+	container := hb.Div().Class("container-fluid")
+
+	// Link columns
+	if len(model.Columns) > 0 {
+		row := hb.Div().Class("row g-3 mb-3")
+		for _, column := range model.Columns {
+			links := hb.UL().Class("list-unstyled mb-0")
+			for _, link := range column.Links {
+				links.Child(hb.LI().Class("mb-1").Child(hb.Hyperlink().
+					Class("link-secondary text-decoration-none").
+					Href(lo.Ternary(link.URL == "", "#", link.URL)).
+					TargetIf(link.Target != "", link.Target).
+					Text(link.Title)))
+			}
+
+			row.Child(hb.Div().Class("col-6 col-md").
+				ChildIf(column.Title != "", hb.H6().Class("text-uppercase small fw-bold").Text(column.Title)).
+				Child(links))
+		}
+		container.Child(row)
+	}
+
+	// Copyright on the left, version and environment on the right
+	bottom := hb.Div().Class("d-flex flex-wrap justify-content-between align-items-center small text-body-secondary")
+	bottom.Child(hb.Div().Text(model.Copyright))
+
+	meta := hb.Div().Class("d-flex align-items-center gap-2")
+	if model.Environment != "" {
+		meta.Child(hb.Span().Class("badge text-bg-warning").Text(model.Environment))
+	}
+	if model.Version != "" {
+		meta.Child(hb.Span().Text(model.Version))
+	}
+	bottom.Child(meta)
+	container.Child(bottom)
+
+	// Custom HTML
+	if model.HTML != "" || model.Fragment != nil {
+		container.Child(hb.Div().Class("mt-3").Child(shared.FragmentPlaceholder(dashboard, shared.FRAGMENT_FOOTER)))
+	}
+
+	return hb.Footer().
+		Class("dashboard-footer border-top py-3 mt-auto").
+		Child(container)
+}
@@ -3,15 +3,18 @@ package bootstrap
 import (
 	"strconv"
 
+	"github.com/dracory/dashboard/shared"
+	templatesshared "github.com/dracory/dashboard/templates/shared"
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
 )
 
-// buildMenuItem creates a menu item for the dashboard menu
-func buildMenuItem(menuItem types.MenuItem, index int) *hb.Tag {
+// buildMenuItem creates a menu item for the dashboard menu, the path is
+// used to give every submenu a unique ID
+func buildMenuItem(dashboard types.DashboardInterface, menuItem types.MenuItem, path string) *hb.Tag {
 	title := menuItem.Title
 	if title == "" {
-		title = "n/a"
+		title = dashboard.Translate(shared.MESSAGE_NOT_AVAILABLE)
 	}
 
 	url := menuItem.URL
@@ -22,30 +25,35 @@ func buildMenuItem(menuItem types.MenuItem, index int) *hb.Tag {
 	icon := menuItem.Icon
 	children := menuItem.Children
 	hasChildren := len(children) > 0
-	submenuID := "submenu_" + strconv.Itoa(index)
+	submenuID := "submenu_" + path
 
 	if hasChildren {
 		url = "#" + submenuID
 	}
 
-	link := hb.NewHyperlink().Class("nav-link align-middle px-0")
+	link := hb.NewHyperlink().
+		Class("nav-link align-middle px-0").
+		ClassIf(menuItem.IsActive, "active").
+		AttrIf(menuItem.IsActive, "aria-current", "page")
 
 	if icon != "" {
 		link.Child(hb.NewSpan().
 			Class("icon").
-			Style("margin-right: 5px;").
-			HTML(icon))
+			Style("margin-inline-end: 5px;").
+			HTML(string(icon)))
 	} else if hasChildren {
-		link.Child(hb.NewRaw(`<svg xmlns="http://www.w3.org/2000/svg" width="8" height="8" fill="currentColor" class="bi bi-caret-right-fill" viewBox="0 0 16 16">
+		link.Child(hb.NewRaw(`<svg xmlns="http://www.w3.org/2000/svg" aria-hidden="true" width="8" height="8" fill="currentColor" class="bi bi-caret-right-fill" viewBox="0 0 16 16">
 		<path d="m12.14 8.753-5.482 4.796c-.646.566-1.658.106-1.658-.753V3.204a1 1 0 0 1 1.659-.753l5.48 4.796a1 1 0 0 1 0 1.506z"/>
 		</svg>`))
 	}
 
-	link.Child(hb.NewSpan().Class("d-inline").HTML(title))
+	link.Child(hb.NewSpan().Class("d-inline").Text(title))
 	link.Href(url)
 
 	if hasChildren {
-		link.Data("bs-toggle", "collapse")
+		link.Data("bs-toggle", "collapse").
+			Attr("aria-expanded", "false").
+			Attr("aria-controls", submenuID)
 	}
 
 	li := hb.NewLI().Class("nav-item").Child(link)
@@ -57,7 +65,7 @@ func buildMenuItem(menuItem types.MenuItem, index int) *hb.Tag {
 			Data("bs-parent", "#DashboardMenu")
 
 		for childIndex, childMenuItem := range children {
-			childItem := buildMenuItem(childMenuItem, childIndex)
+			childItem := buildMenuItem(dashboard, childMenuItem, path+"_"+strconv.Itoa(childIndex))
 			ul.Child(childItem)
 		}
 
@@ -69,15 +77,32 @@ func buildMenuItem(menuItem types.MenuItem, index int) *hb.Tag {
 
 // dashboardMenuNavbar generates the HTML for the dashboard menu navbar
 func dashboardMenuNavbar(dashboard types.DashboardInterface) string {
-	nav := hb.NewNav().Class("nav nav-pills flex-column mb-auto").ID("DashboardMenu")
+	nav := hb.NewNav().
+		Class("nav nav-pills flex-column mb-auto").
+		ID("DashboardMenu").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_MAIN_NAVIGATION))
 
 	for i, item := range dashboard.GetMenuMainItems() {
-		nav.Child(buildMenuItem(item, i))
+		nav.Child(buildMenuItem(dashboard, item, strconv.Itoa(i)))
 	}
 
 	return nav.ToHTML()
 }
 
+// menuPanelChildren returns the children of a panel holding the main menu:
+// the sidebar-top region, the menu and the sidebar-bottom region
+func menuPanelChildren(dashboard types.DashboardInterface, menu hb.TagInterface) []hb.TagInterface {
+	children := []hb.TagInterface{}
+	if region := templatesshared.Region(dashboard, shared.REGION_SIDEBAR_TOP); region != nil {
+		children = append(children, region.Class("mb-3"))
+	}
+	children = append(children, menu)
+	if region := templatesshared.Region(dashboard, shared.REGION_SIDEBAR_BOTTOM); region != nil {
+		children = append(children, region.Class("mt-3"))
+	}
+	return children
+}
+
 // menuOffcanvas generates the offcanvas menu HTML
 func menuOffcanvas(dashboard types.DashboardInterface) *hb.Tag {
 	// Get the background class based on the current theme
@@ -101,18 +126,16 @@ func menuOffcanvas(dashboard types.DashboardInterface) *hb.Tag {
 					hb.NewH5().
 						ID("OffcanvasMenuLabel").
 						Class("offcanvas-title").
-						Text("Menu"),
+						Text(dashboard.Translate(shared.MESSAGE_MENU)),
 					hb.NewButton().
 						Class("btn-close btn-close-white").
 						ClassIf(backgroundClass == "bg-light", "text-bg-light").
 						Type(hb.TYPE_BUTTON).
 						Data("bs-dismiss", "offcanvas").
-						Attr("aria-label", "Close"),
+						Attr("aria-label", dashboard.Translate(shared.MESSAGE_CLOSE)),
 				}),
 			hb.NewDiv().Class("offcanvas-body").
-				Children([]hb.TagInterface{
-					hb.Raw(dashboardMenuNavbar(dashboard)),
-				}),
+				Children(menuPanelChildren(dashboard, hb.Raw(dashboardMenuNavbar(dashboard)))),
 		})
 
 	return offcanvas
@@ -122,24 +145,22 @@ func menuOffcanvas(dashboard types.DashboardInterface) *hb.Tag {
 func menuModal(dashboard types.DashboardInterface) *hb.Tag {
 	modalHeader := hb.NewDiv().Class("modal-header").
 		Children([]hb.TagInterface{
-			hb.NewH5().HTML("Menu").Class("modal-title").ID("ModalDashboardMenuLabel"),
+			hb.NewH5().Text(dashboard.Translate(shared.MESSAGE_MENU)).Class("modal-title").ID("ModalDashboardMenuLabel"),
 			hb.NewButton().Attrs(map[string]string{
 				"type":            "button",
 				"class":           "btn-close",
 				"data-bs-dismiss": "modal",
-				"aria-label":      "Close",
+				"aria-label":      dashboard.Translate(shared.MESSAGE_CLOSE),
 			}),
 		})
 
 	modalBody := hb.NewDiv().Class("modal-body").
-		Children([]hb.TagInterface{
-			hb.Raw(dashboardMenuNavbar(dashboard)),
-		})
+		Children(menuPanelChildren(dashboard, hb.Raw(dashboardMenuNavbar(dashboard))))
 
 	modalFooter := hb.NewDiv().Class("modal-footer").
 		Children([]hb.TagInterface{
 			hb.NewButton().
-				HTML("Close").
+				Text(dashboard.Translate(shared.MESSAGE_CLOSE)).
 				Class("btn btn-secondary w-100").
 				Data("bs-dismiss", "modal"),
 		})
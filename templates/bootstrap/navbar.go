@@ -1,9 +1,11 @@
 package bootstrap
 
 import (
+	"net/url"
 	"strings"
 
 	"github.com/dracory/dashboard/shared"
+	templatesshared "github.com/dracory/dashboard/templates/shared"
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
 	"github.com/samber/lo"
@@ -23,7 +25,7 @@ func topNavigation(dashboard types.DashboardInterface) string {
 
 	navbarThemeBackgroundClass := navbarBackgroundThemeClass(dashboard.GetNavbarBackgroundColor(), dashboard.GetNavbarBackgroundColorMode())
 
-	iconStyle := "margin-top:-4px;margin-right:5px;"
+	iconStyle := "margin-top:-4px;margin-inline-end:5px;"
 	navbarTextColor := dashboard.GetNavbarTextColor()
 	navbarBackgroundColor := dashboard.GetNavbarBackgroundColor()
 	navbarBackgroundColorMode := dashboard.GetNavbarBackgroundColorMode()
@@ -33,22 +35,24 @@ func topNavigation(dashboard types.DashboardInterface) string {
 	hasNavbarTextColor := navbarTextColor != ""
 	user := dashboard.GetUser()
 
-	dropdownUser := navbarDropdownUser(iconStyle, navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode, *user, dashboard.GetMenuUserItems())
-	dropdownQuickAccess := navbarDropdownQuickAccess(iconStyle, navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode, dashboard.GetMenuQuickAccessItems())
-	dropdownThemeSwitch := navbarDropdownThemeSwitch(navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode, dashboard.GetTheme(), dashboard.GetThemeHandlerUrl())
+	dropdownQuickAccess := navbarDropdownQuickAccess(dashboard.Translate(shared.MESSAGE_QUICK_ACCESS), iconStyle, navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode, dashboard.GetMenuQuickAccessItems())
+	dropdownThemeSwitch := navbarDropdownThemeSwitch(dashboard.Translate(shared.MESSAGE_THEME), navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode, dashboard.GetTheme(), dashboard.GetThemeHandlerUrl(), dashboard.GetThemesAvailable())
 
 	buttonTheme := navbarButtonThemeClass(navbarBackgroundColor, navbarBackgroundColorMode)
 	buttonMenuToggle := buttonMenuToggle(buttonTheme, hasNavbarTextColor, navbarTextColor, dashboard, iconStyle)
 	buttonOffcanvasToggle := buttonOffcanvasToggle(buttonTheme, hasNavbarTextColor, navbarTextColor, iconStyle, dashboard)
 
 	buttonMainMenu := buttonOffcanvasToggle
-	if dashboard.GetMenuType() == shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_MODAL {
+	switch dashboard.GetMenuType() {
+	case shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_MODAL:
 		buttonMainMenu = buttonMenuToggle
+	case shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_SIDEBAR:
+		buttonMainMenu = buttonSidebarToggle(buttonTheme, hasNavbarTextColor, navbarTextColor, iconStyle, dashboard)
 	}
 
 	logo := lo.
-		If(hasLogoRawHTML, hb.Raw(dashboard.GetLogoRawHtml())).
-		ElseIf(hasLogoImage, hb.Image(dashboard.GetLogoImageURL()).Style("max-height:35px;height:35px; width:auto;")).
+		If(hasLogoRawHTML, hb.Raw(string(dashboard.GetLogoRawHtml()))).
+		ElseIf(hasLogoImage, hb.Image(dashboard.GetLogoImageURL()).Alt(dashboard.Translate(shared.MESSAGE_HOME)).Style("max-height:35px;height:35px; width:auto;")).
 		Else(nil)
 
 	logoLink := hb.Hyperlink().
@@ -57,75 +61,84 @@ func topNavigation(dashboard types.DashboardInterface) string {
 		Child(logo)
 
 	loginLink := hb.Hyperlink().
-		Text("Login").
+		Text(dashboard.Translate(shared.MESSAGE_LOGIN)).
 		Href(dashboard.GetLoginURL()).
 		//Class("btn "+buttonTheme+" float-end").
 		Class("btn btn-outline-info float-end").
 		StyleIf(hasNavbarTextColor, "color: "+navbarTextColor+";").
-		Style("margin-left:10px;")
+		Style("margin-inline-start:10px;")
 
 	registerLink := hb.Hyperlink().
-		Text("Register").
+		Text(dashboard.Translate(shared.MESSAGE_REGISTER)).
 		Href(dashboard.GetRegisterURL()).
 		Class("btn "+buttonTheme+" float-end").
 		StyleIf(hasNavbarTextColor, "color: "+navbarTextColor+";").
-		Style("margin-left:10px;  border:none;")
+		Style("margin-inline-start:10px;  border:none;")
 
-	// Create a container for the right-aligned items
-	rightItems := hb.Div().Class("d-flex align-items-center ms-auto")
+	// Create items array and add conditionally
+	var items []hb.TagInterface
 
-	// Add quick access dropdown if items exist
-	if len(dashboard.GetMenuQuickAccessItems()) > 0 {
-		rightItems.Child(dropdownQuickAccess)
-	}
+	// Add main menu button
+	items = append(items, buttonMainMenu)
 
-	// Add theme switch dropdown if handler URL exists
-	if dashboard.GetThemeHandlerUrl() != "" {
-		rightItems.Child(hb.Div().Style("margin-left:10px;").Child(dropdownThemeSwitch))
+	// Navbar start region - add conditionally
+	if region := templatesshared.Region(dashboard, shared.REGION_NAVBAR_START); region != nil {
+		items = append(items, region.Class("d-inline-flex align-items-center gap-2 align-middle").Style("margin-inline-start:10px;"))
 	}
 
-	// Add user dropdown or login/register links
-	if user != nil {
-		rightItems.Child(hb.Div().Style("margin-left:10px;").Child(dropdownUser))
-	} else {
-		if dashboard.GetLoginURL() != "" {
-			rightItems.Child(loginLink)
-		}
-		if dashboard.GetRegisterURL() != "" {
-			rightItems.Child(registerLink)
-		}
+	// Environment badge - add conditionally
+	if badge := templatesshared.EnvironmentBadge(dashboard); badge != nil {
+		items = append(items, hb.Div().Class("d-inline-block align-middle").Style("margin-inline-start:10px;").Child(badge))
 	}
 
-	// Create items array and add conditionally
-	var items []hb.TagInterface
-
-	// Add main menu button
-	items = append(items, buttonMainMenu)
+	// Search box - add conditionally
+	if search := navbarSearch(dashboard); search != nil {
+		items = append(items, hb.Div().Class("d-inline-block align-middle").Style("margin-inline-start:10px;").Child(search))
+	}
 
 	// User Menu - add conditionally
-	hasUser := user != nil && (user.FirstName != "" || user.LastName != "")
+	hasUser := user != nil && user.Name() != ""
 	if hasUser {
-		userDiv := hb.Div().Class("float-end").Style("margin-left:10px;").Child(dropdownUser)
+		avatar := templatesshared.UserAvatar(dashboard, *user, 24, "")
+		dropdownUser := navbarDropdownUser(avatar, navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode, *user, dashboard.GetMenuUserItems(),
+			templatesshared.ImpersonationSignedInAs(dashboard), templatesshared.ImpersonationStopForm(dashboard, "dropdown-item"))
+		userDiv := hb.Div().Class("float-end").Style("margin-inline-start:10px;").Child(dropdownUser)
 		items = append(items, userDiv)
 	}
 
+	// Login and register links - add conditionally, when no user
+	if user == nil {
+		if dashboard.GetRegisterURL() != "" {
+			items = append(items, registerLink)
+		}
+		if dashboard.GetLoginURL() != "" {
+			items = append(items, loginLink)
+		}
+	}
+
 	// Theme Switcher - add conditionally
 	hasThemeHandler := dashboard.GetThemeHandlerUrl() != ""
 	if hasThemeHandler {
-		themeDiv := hb.Div().Class("float-end").Style("margin-left:10px;").Child(dropdownThemeSwitch)
+		themeDiv := hb.Div().Class("float-end").Style("margin-inline-start:10px;").Child(dropdownThemeSwitch)
 		items = append(items, themeDiv)
 	}
 
 	// Quick Access Menu - add conditionally
 	hasQuickAccess := len(dashboard.GetMenuQuickAccessItems()) > 0
 	if hasQuickAccess {
-		quickAccessDiv := hb.Div().Class("float-end").Style("margin-left:10px;").Child(dropdownQuickAccess)
+		quickAccessDiv := hb.Div().Class("float-end").Style("margin-inline-start:10px;").Child(dropdownQuickAccess)
 		items = append(items, quickAccessDiv)
 	}
 
+	// Navbar end region - add conditionally
+	if region := templatesshared.Region(dashboard, shared.REGION_NAVBAR_END); region != nil {
+		items = append(items, region.Class("float-end d-flex align-items-center gap-2").Style("margin-inline-start:10px;"))
+	}
+
 	toolbar := hb.Nav().
 		ID("Toolbar").
 		Class("navbar").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_TOOLBAR)).
 		ClassIf(navbarHasBackgroundThemeClass(navbarBackgroundColor, navbarBackgroundColorMode), navbarThemeBackgroundClass).
 		Style("z-index: 3;box-shadow: 0 5px 20px rgba(0, 0, 0, 0.1);transition: all .2s ease;padding-left: 20px;padding-right: 20px; display:block;").
 		StyleIf(hasNavbarBackgroundColor, `background-color: `+navbarBackgroundColor+`;`).
@@ -133,16 +146,21 @@ func topNavigation(dashboard types.DashboardInterface) string {
 		ChildIf(hasLogo, logoLink).
 		Children(items)
 
-	// Create the main menu based on menu type
-	mainMenu := lo.TernaryF(dashboard.GetMenuType() == shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_MODAL, func() *hb.Tag {
-		return menuModal(dashboard)
-	}, func() *hb.Tag {
-		return menuOffcanvas(dashboard)
-	})
+	// Create the main menu based on menu type, the sidebar
+	// menu type renders its menu as part of the layout
+	var mainMenu *hb.Tag
+	switch dashboard.GetMenuType() {
+	case shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_MODAL:
+		mainMenu = menuModal(dashboard)
+	case shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_SIDEBAR:
+		mainMenu = nil
+	default:
+		mainMenu = menuOffcanvas(dashboard)
+	}
 
 	return hb.Wrap().
 		Child(toolbar).
-		Child(mainMenu).
+		ChildIf(mainMenu != nil, mainMenu).
 		ToHTML()
 }
 
@@ -153,10 +171,12 @@ func buttonOffcanvasToggle(buttonTheme string, hasNavbarTextColor bool, navbarTe
 		StyleIf(hasNavbarTextColor, "color: "+navbarTextColor+";").
 		Data("bs-toggle", "offcanvas").
 		Data("bs-target", "#OffcanvasMenu").
-		Child(hb.I().Class("bi bi-list").Style(iconStyle)).
+		Attr("aria-controls", "OffcanvasMenu").
+		AttrIf(!dashboard.GetMenuShowText(), "aria-label", dashboard.Translate(shared.MESSAGE_MENU)).
+		Child(hb.I().Class("bi bi-list").Style(iconStyle).Attr("aria-hidden", "true")).
 		ChildIf(dashboard.GetMenuShowText(), hb.Span().
 			Class("d-none d-md-inline-block").
-			HTML("Menu"))
+			Text(dashboard.Translate(shared.MESSAGE_MENU)))
 	return buttonOffcanvasToggle
 }
 
@@ -168,26 +188,32 @@ func buttonMenuToggle(buttonTheme string, hasNavbarTextColor bool, navbarTextCol
 		Data("bs-toggle", "modal").
 		Data("bs-target", "#ModalDashboardMenu").
 		Children([]hb.TagInterface{
-			hb.I().Class("bi bi-list").Style(iconStyle),
+			hb.I().Class("bi bi-list").Style(iconStyle).Attr("aria-hidden", "true"),
 			hb.Span().
 				Class("d-none d-md-inline-block").
-				HTML("Menu"),
+				Text(dashboard.Translate(shared.MESSAGE_MENU)),
 		})
 	return buttonMenuToggle
 }
 
-// navbarDropdownUser creates a user dropdown menu
+// navbarDropdownUser creates a user dropdown menu, the button shows the
+// avatar and the name, the menu starts with the name and the job title or
+// the email. While impersonating, the menu names the real user and ends
+// with the stop impersonating form.
 func navbarDropdownUser(
-	iconStyle,
+	avatar *hb.Tag,
 	navbarTextColor,
 	navbarBackgroundColor,
 	navbarBackgroundColorMode string,
 	user types.User,
 	userMenuItems []types.MenuItem,
+	signedInAs string,
+	stopImpersonating *hb.Tag,
 ) *hb.Tag {
 	hasNavbarTextColor := lo.Ternary(navbarTextColor == "", false, true)
 	buttonTheme := navbarButtonThemeClass(navbarBackgroundColor, navbarBackgroundColorMode)
-	userName := user.FirstName + " " + user.LastName
+	userName := user.Name()
+	userDetails := lo.Ternary(user.JobTitle != "", user.JobTitle, user.Email)
 
 	dropdownUser := hb.Div().
 		Class("dropdown").
@@ -199,15 +225,23 @@ func navbarDropdownUser(
 				StyleIf(hasNavbarTextColor, "color: "+navbarTextColor+";").
 				Type(hb.TYPE_BUTTON).
 				Data("bs-toggle", "dropdown").
+				Aria("expanded", "false").
 				Children([]hb.TagInterface{
-					hb.I().Class("bi bi-person").Style(iconStyle),
+					avatar,
 					hb.Span().
 						Class("d-none d-md-inline-block").
+						Style("margin-inline-start:5px;").
 						Text(userName),
 				}),
 			hb.UL().
 				Class("dropdown-menu dropdown-menu-dark").
 				Class(buttonTheme).
+				Aria("labelledby", "ButtonUser").
+				Child(hb.LI().Child(hb.Div().
+					Class("dropdown-header").
+					Child(hb.Div().Class("fw-semibold").Text(userName)).
+					ChildIf(userDetails != "", hb.Div().Class("small").Text(userDetails)).
+					ChildIf(signedInAs != "", hb.Div().Class("small text-warning").Text(signedInAs)))).
 				Children(lo.Map(userMenuItems, func(item types.MenuItem, _ int) hb.TagInterface {
 					target := lo.Ternary(item.Target == "", "_self", item.Target)
 					url := lo.Ternary(item.URL == "", "#", item.URL)
@@ -221,20 +255,22 @@ func navbarDropdownUser(
 						hb.If(item.Title != "",
 							hb.Hyperlink().
 								Class("dropdown-item").
-								ChildIf(item.Icon != "", hb.Span().Class("icon").Style("margin-right: 5px;").HTML(item.Icon)).
+								ChildIf(item.Icon != "", hb.Span().Class("icon").Style("margin-inline-end: 5px;").HTML(string(item.Icon))).
 								Text(item.Title).
 								Href(url).
 								Target(target),
 						),
 					})
-				})),
+				})).
+				ChildIf(stopImpersonating != nil, hb.LI().Child(hb.HR().Class("dropdown-divider"))).
+				ChildIf(stopImpersonating != nil, hb.LI().Child(stopImpersonating)),
 		})
 
 	return dropdownUser
 }
 
 // navbarDropdownQuickAccess creates a quick access dropdown menu
-func navbarDropdownQuickAccess(iconStyle, navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode string, quickAccessItems []types.MenuItem) *hb.Tag {
+func navbarDropdownQuickAccess(label, iconStyle, navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode string, quickAccessItems []types.MenuItem) *hb.Tag {
 	hasNavbarTextColor := navbarTextColor != ""
 	buttonTheme := navbarButtonThemeClass(navbarBackgroundColor, navbarBackgroundColorMode)
 
@@ -246,8 +282,9 @@ func navbarDropdownQuickAccess(iconStyle, navbarTextColor, navbarBackgroundColor
 		Type(hb.TYPE_BUTTON).
 		Data("bs-toggle", "dropdown").
 		Aria("expanded", "false").
+		Aria("label", label).
 		Child(hb.Span().Class("d-flex align-items-center").Children([]hb.TagInterface{
-			hb.I().Class("bi bi-grid-3x3-gap-fill").Style(iconStyle),
+			hb.I().Class("bi bi-grid-3x3-gap-fill").Style(iconStyle).Attr("aria-hidden", "true"),
 		}))
 
 	dropdownMenu := hb.Div().
@@ -269,7 +306,7 @@ func navbarDropdownQuickAccess(iconStyle, navbarTextColor, navbarBackgroundColor
 				Class("dropdown-item d-flex align-items-center").
 				Href(item.URL).
 				Children([]hb.TagInterface{
-					hb.Span().Class("me-2").HTML(icon),
+					hb.Span().Class("me-2").HTML(string(icon)),
 					hb.Span().Text(item.Title),
 				})
 
@@ -288,7 +325,7 @@ func navbarDropdownQuickAccess(iconStyle, navbarTextColor, navbarBackgroundColor
 }
 
 // navbarDropdownThemeSwitch creates a theme switcher dropdown
-func navbarDropdownThemeSwitch(navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode, currentTheme, themeHandlerUrl string) *hb.Tag {
+func navbarDropdownThemeSwitch(label, navbarTextColor, navbarBackgroundColor, navbarBackgroundColorMode, currentTheme, themeHandlerUrl string, themes []types.Theme) *hb.Tag {
 	hasNavbarTextColor := navbarTextColor != ""
 	buttonTheme := navbarButtonThemeClass(navbarBackgroundColor, navbarBackgroundColorMode)
 
@@ -297,45 +334,30 @@ func navbarDropdownThemeSwitch(navbarTextColor, navbarBackgroundColor, navbarBac
 	// Use default handler URL if none provided
 	handlerUrl := lo.Ternary(themeHandlerUrl == "", "/", themeHandlerUrl)
 
-	// Generate Light Theme dropdown items
-	lightDropdownItems := lo.Map(lo.Keys(themesLight), func(theme string, index int) hb.TagInterface {
-		name := themesLight[theme]
+	themeDropdownItem := func(theme types.Theme, _ int) hb.TagInterface {
 		// Mark current theme as active
-		active := lo.Ternary(currentTheme == theme, " active", "")
+		active := lo.Ternary(currentTheme == theme.ID, " active", "")
 		// Build URL with proper query parameter handling
-		url := lo.Ternary(strings.Contains(handlerUrl, "?"),
-			handlerUrl+"&theme="+theme,
-			handlerUrl+"?theme="+theme)
+		themeUrl := lo.Ternary(strings.Contains(handlerUrl, "?"),
+			handlerUrl+"&theme="+url.QueryEscape(theme.ID),
+			handlerUrl+"?theme="+url.QueryEscape(theme.ID))
 
 		return hb.LI().Children([]hb.TagInterface{
 			hb.Hyperlink().
 				Class("dropdown-item"+active).
-				Child(hb.I().Class("bi bi-sun me-2")).
-				HTML(name).
-				Href(url).
+				AttrIf(currentTheme == theme.ID, "aria-current", "true").
+				Child(lo.Ternary(theme.IsDark, hb.I().Class("bi bi-moon-stars-fill me-2"), hb.I().Class("bi bi-sun me-2"))).
+				Text(theme.Name).
+				Href(themeUrl).
 				Attr("ref", "nofollow"),
 		})
-	})
+	}
 
-	// Generate Dark Theme dropdown items
-	darkDropdownItems := lo.Map(lo.Keys(themesDark), func(theme string, index int) hb.TagInterface {
-		name := themesDark[theme]
-		// Mark current theme as active
-		active := lo.Ternary(currentTheme == theme, " active", "")
-		// Build URL with proper query parameter handling
-		url := lo.Ternary(strings.Contains(handlerUrl, "?"),
-			handlerUrl+"&theme="+theme,
-			handlerUrl+"?theme="+theme)
+	// Generate Light Theme dropdown items
+	lightDropdownItems := lo.Map(lo.Filter(themes, func(theme types.Theme, _ int) bool { return !theme.IsDark }), themeDropdownItem)
 
-		return hb.LI().Children([]hb.TagInterface{
-			hb.Hyperlink().
-				Class("dropdown-item"+active).
-				Child(hb.I().Class("bi bi-moon-stars-fill me-2")).
-				HTML(name).
-				Href(url).
-				Attr("ref", "nofollow"),
-		})
-	})
+	// Generate Dark Theme dropdown items
+	darkDropdownItems := lo.Map(lo.Filter(themes, func(theme types.Theme, _ int) bool { return theme.IsDark }), themeDropdownItem)
 
 	button := hb.Button().
 		ID("buttonTheme").
@@ -344,9 +366,12 @@ func navbarDropdownThemeSwitch(navbarTextColor, navbarBackgroundColor, navbarBac
 		Style("background:none;border:0px;").
 		// Style("gap:0.25rem;").
 		StyleIf(hasNavbarTextColor, "color:"+navbarTextColor).
+		Type(hb.TYPE_BUTTON).
 		Data("bs-toggle", "dropdown").
+		Aria("expanded", "false").
+		Aria("label", label).
 		Children([]hb.TagInterface{
-			lo.Ternary(isDark, hb.I().Class("bi bi-sun"), hb.I().Class("bi bi-moon-stars-fill")),
+			lo.Ternary(isDark, hb.I().Class("bi bi-sun"), hb.I().Class("bi bi-moon-stars-fill")).Attr("aria-hidden", "true"),
 		})
 
 	return hb.Div().
@@ -354,6 +379,7 @@ func navbarDropdownThemeSwitch(navbarTextColor, navbarBackgroundColor, navbarBac
 		Child(button).
 		Child(hb.UL().
 			Class(buttonTheme+" dropdown-menu dropdown-menu-dark dropdown-menu-end").
+			Aria("labelledby", "buttonTheme").
 			Children(lightDropdownItems).
 			ChildIf(
 				len(lo.Filter(darkDropdownItems, func(item hb.TagInterface, _ int) bool { return item != nil })) > 0 && len(lo.Filter(lightDropdownItems, func(item hb.TagInterface, _ int) bool { return item != nil })) > 0,
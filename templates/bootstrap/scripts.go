@@ -1,5 +1,7 @@
 package bootstrap
 
+import "encoding/json"
+
 // templateScript returns the JavaScript for the template
 func templateScript() string {
 	return `
@@ -61,3 +63,36 @@ func templateScript() string {
 		});
 	`
 }
+
+// sidebarStateScript returns the JavaScript which collapses and expands
+// the sidebar and reports its state to the sidebar state handler, so that
+// the state survives page loads
+func sidebarStateScript(sidebarHandlerUrl string) string {
+	if sidebarHandlerUrl == "" {
+		return ""
+	}
+
+	handlerUrl, _ := json.Marshal(sidebarHandlerUrl)
+
+	return `
+document.addEventListener('DOMContentLoaded', function() {
+	var handlerUrl = ` + string(handlerUrl) + `;
+
+	function reportSidebarState(collapsed) {
+		var url = handlerUrl + (handlerUrl.indexOf('?') === -1 ? '?' : '&') + 'collapsed=' + (collapsed ? '1' : '0');
+		fetch(url, { method: 'POST', credentials: 'same-origin' }).catch(function(e) {
+			console.warn('Error saving sidebar state:', e);
+		});
+	}
+
+	document.querySelectorAll('[data-sidebar-toggle]').forEach(function(toggle) {
+		toggle.addEventListener('click', function(event) {
+			event.preventDefault();
+			var collapsed = document.body.classList.toggle('sidebar-collapse');
+			toggle.setAttribute('aria-expanded', collapsed ? 'false' : 'true');
+			reportSidebarState(collapsed);
+		});
+	});
+});
+`
+}
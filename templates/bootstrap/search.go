@@ -0,0 +1,42 @@
+package bootstrap
+
+import (
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// navbarSearch creates the search box of the toolbar, or nil when
+// no search handler is set
+func navbarSearch(dashboard types.DashboardInterface) *hb.Tag {
+	if dashboard.GetSearchHandlerUrl() == "" {
+		return nil
+	}
+
+	input := hb.Input().
+		Type("search").
+		Name("q").
+		Class("form-control form-control-sm").
+		Placeholder(dashboard.Translate(shared.MESSAGE_SEARCH_PLACEHOLDER)).
+		Attr("autocomplete", "off").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_SEARCH)).
+		Attr("aria-expanded", "false").
+		Attr("aria-controls", "DashboardSearchResults")
+
+	results := hb.Div().
+		ID("DashboardSearchResults").
+		Class("dropdown-menu shadow w-100").
+		Style("max-height:400px;overflow-y:auto;").
+		Role("listbox").
+		Data("search-results", "true")
+
+	return hb.Form().
+		Class("position-relative d-none d-md-block").
+		Style("min-width:250px;").
+		Role("search").
+		Attr("action", dashboard.GetSearchHandlerUrl()).
+		Attr("method", "get").
+		Data("dashboard-search", "true").
+		Child(input).
+		Child(results)
+}
@@ -0,0 +1,166 @@
+package bootstrap
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// isMenuTypeSidebar returns whether the main menu is shown in the sidebar
+func isMenuTypeSidebar(dashboard types.DashboardInterface) bool {
+	return dashboard.GetMenuType() == shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_SIDEBAR
+}
+
+// sidebarLayout generates the layout for the sidebar menu type, with the
+// main menu in a fixed left sidebar next to the toolbar and the content
+func sidebarLayout(dashboard types.DashboardInterface, content *hb.Tag) string {
+	mainColumn := hb.Div().
+		Class("dashboard-main flex-grow-1 d-flex flex-column").
+		Child(hb.Raw(topNavigation(dashboard))).
+		Child(content.Class("flex-grow-1"))
+
+	if footer := footer(dashboard); footer != nil {
+		mainColumn.Child(footer)
+	}
+
+	return hb.Div().
+		Class("dashboard-sidebar-layout d-flex").
+		Child(sidebar(dashboard)).
+		Child(mainColumn).
+		ToHTML()
+}
+
+// sidebar generates the main menu sidebar. On large screens it is always
+// visible (an icon-only rail when the menu text is hidden), on small
+// screens it is shown as an offcanvas
+func sidebar(dashboard types.DashboardInterface) *hb.Tag {
+	backgroundClass := "bg-dark"
+	if navbarBg, ok := dashboard.GetNavbarBackground(); ok && navbarBg != "" {
+		backgroundClass = navbarBg
+	}
+
+	isLight := backgroundClass == "bg-light"
+
+	header := hb.Div().Class("offcanvas-header").
+		Child(hb.H5().
+			ID("DashboardSidebarLabel").
+			Class("offcanvas-title").
+			Text(dashboard.Translate(shared.MESSAGE_MENU))).
+		Child(hb.Button().
+			Class("btn-close").
+			ClassIf(!isLight, "btn-close-white").
+			Type(hb.TYPE_BUTTON).
+			Data("bs-dismiss", "offcanvas").
+			Data("bs-target", "#DashboardSidebar").
+			Attr("aria-label", dashboard.Translate(shared.MESSAGE_CLOSE)))
+
+	nav := hb.Nav().
+		ID("DashboardSidebarMenu").
+		Class("nav nav-pills flex-column mb-auto w-100").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_MAIN_NAVIGATION))
+
+	for index, item := range dashboard.GetMenuMainItems() {
+		nav.Child(buildSidebarMenuItem(dashboard, item, strconv.Itoa(index)))
+	}
+
+	return hb.Aside().
+		ID("DashboardSidebar").
+		Class("dashboard-sidebar offcanvas-lg offcanvas-start").
+		Class(backgroundClass).
+		ClassIfElse(isLight, "text-bg-light", "text-bg-dark").
+		ClassIf(!dashboard.GetMenuShowText(), "sidebar-rail").
+		Attr("tabindex", "-1").
+		Attr("aria-labelledby", "DashboardSidebarLabel").
+		Child(header).
+		Child(hb.Div().Class("offcanvas-body").Children(menuPanelChildren(dashboard, nav)))
+}
+
+// buildSidebarMenuItem creates a sidebar menu item, the path is used
+// to give every submenu a unique ID
+func buildSidebarMenuItem(dashboard types.DashboardInterface, menuItem types.MenuItem, path string) *hb.Tag {
+	title := lo.Ternary(menuItem.Title == "", dashboard.Translate(shared.MESSAGE_NOT_AVAILABLE), menuItem.Title)
+	url := lo.Ternary(menuItem.URL == "", "#", menuItem.URL)
+	hasChildren := len(menuItem.Children) > 0
+	submenuID := "sidebar_submenu_" + path
+
+	if hasChildren {
+		url = "#" + submenuID
+	}
+
+	// The icon stays visible in the rail mode, items without an icon
+	// show the first letter of their title instead
+	icon := hb.Span().Class("sidebar-icon").Attr("aria-hidden", "true")
+	if menuItem.Icon != "" {
+		icon.HTML(string(menuItem.Icon))
+	} else {
+		initial, _ := utf8.DecodeRuneInString(title)
+		icon.Text(strings.ToUpper(string(initial)))
+	}
+
+	link := hb.Hyperlink().
+		Class("nav-link d-flex align-items-center").
+		ClassIf(menuItem.IsActive, "active").
+		AttrIf(menuItem.IsActive, "aria-current", "page").
+		Href(url).
+		Attr("title", title).
+		Child(icon).
+		Child(hb.Span().Class("sidebar-text").Text(title))
+
+	if hasChildren {
+		link.Data("bs-toggle", "collapse").
+			Attr("aria-expanded", "false").
+			Attr("aria-controls", submenuID)
+	}
+
+	navItem := hb.Div().Class("nav-item").Child(link)
+
+	if hasChildren {
+		submenu := hb.Div().
+			ID(submenuID).
+			Class("collapse nav flex-column ms-2")
+
+		for childIndex, child := range menuItem.Children {
+			submenu.Child(buildSidebarMenuItem(dashboard, child, path+"_"+strconv.Itoa(childIndex)))
+		}
+
+		navItem.Child(submenu)
+	}
+
+	return navItem
+}
+
+// buttonSidebarToggle creates the toolbar buttons of the sidebar menu type,
+// one collapsing the sidebar on large screens and one opening the
+// offcanvas on small screens
+func buttonSidebarToggle(buttonTheme string, hasNavbarTextColor bool, navbarTextColor string, iconStyle string, dashboard types.DashboardInterface) *hb.Tag {
+	buttonCollapse := hb.Button().
+		Class("btn "+buttonTheme+" d-none d-lg-inline-block").
+		Style("background: none; border:none;").
+		StyleIf(hasNavbarTextColor, "color: "+navbarTextColor+";").
+		Type(hb.TYPE_BUTTON).
+		Data("sidebar-toggle", "true").
+		Attr("aria-controls", "DashboardSidebar").
+		Attr("aria-expanded", lo.Ternary(dashboard.GetSidebarCollapsed(), "false", "true")).
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_TOGGLE_SIDEBAR)).
+		Child(hb.I().Class("bi bi-layout-sidebar").Style(iconStyle))
+
+	buttonOffcanvas := hb.Button().
+		Class("btn "+buttonTheme+" d-lg-none").
+		Style("background: none; border:none;").
+		StyleIf(hasNavbarTextColor, "color: "+navbarTextColor+";").
+		Type(hb.TYPE_BUTTON).
+		Data("bs-toggle", "offcanvas").
+		Data("bs-target", "#DashboardSidebar").
+		Attr("aria-controls", "DashboardSidebar").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_MENU)).
+		Child(hb.I().Class("bi bi-list").Style(iconStyle))
+
+	return hb.Wrap().
+		Child(buttonCollapse).
+		Child(buttonOffcanvas)
+}
@@ -0,0 +1,89 @@
+package bootstrap_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func renderSidebar(showText, collapsed bool) string {
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+	d.SetMenuType(shared.TEMPLATE_BOOTSTRAP_MENU_TYPE_SIDEBAR)
+	d.SetMenuShowText(showText)
+	d.SetSidebarCollapsed(collapsed)
+	d.SetTitle("Sidebar Test")
+	d.SetContent("<p>Content</p>")
+	d.SetUser(types.User{FirstName: "Jane", LastName: "Doe"})
+	d.SetMenuMainItems([]types.MenuItem{
+		{Title: "Home", URL: "/", Icon: `<i class="bi bi-house"></i>`},
+		{Title: "reports", Children: []types.MenuItem{
+			{Title: "Sales", URL: "/reports/sales"},
+			{Title: "Stock", Children: []types.MenuItem{
+				{Title: "Warehouse", URL: "/reports/stock/warehouse"},
+			}},
+		}},
+	})
+	return d.ToHTML()
+}
+
+func TestSidebarMenuType(t *testing.T) {
+	html := renderSidebar(true, false)
+
+	expected := []string{
+		`<aside aria-labelledby="DashboardSidebarLabel" class="dashboard-sidebar offcanvas-lg offcanvas-start bg-dark text-bg-dark" id="DashboardSidebar" tabindex="-1">`,
+		`data-bs-target="#DashboardSidebar" data-bs-toggle="offcanvas"`,
+		`data-sidebar-toggle="true"`,
+		`id="sidebar_submenu_1"`,
+		`id="sidebar_submenu_1_1"`,
+		`<span aria-hidden="true" class="sidebar-icon">R</span>`,
+		`<main class="flex-grow-1" id="DashboardContent" style="outline:none;" tabindex="-1"><p>Content</p></main>`,
+	}
+
+	for _, s := range expected {
+		if !strings.Contains(html, s) {
+			t.Errorf("expected HTML to contain %q", s)
+		}
+	}
+
+	if strings.Contains(html, `id="OffcanvasMenu"`) || strings.Contains(html, `id="ModalDashboardMenu"`) {
+		t.Error("expected no offcanvas or modal menu for the sidebar menu type")
+	}
+}
+
+func TestSidebarRailAndCollapsed(t *testing.T) {
+	html := renderSidebar(false, true)
+
+	if !strings.Contains(html, `class="dashboard-sidebar offcanvas-lg offcanvas-start bg-dark text-bg-dark sidebar-rail"`) {
+		t.Error("expected the sidebar in rail mode when the menu text is hidden")
+	}
+
+	body := regexp.MustCompile(`<body[^>]*>`).FindString(html)
+	if !strings.Contains(body, "sidebar-collapse") {
+		t.Errorf("expected the collapsed state on the body, got %q", body)
+	}
+
+	if !strings.Contains(html, `aria-expanded="false" aria-label="Toggle sidebar"`) {
+		t.Error("expected the collapse toggle to report the collapsed state")
+	}
+}
+
+func TestSidebarNotRenderedForOtherMenuTypes(t *testing.T) {
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+	d.SetSidebarCollapsed(true)
+	d.SetUser(types.User{FirstName: "Jane"})
+	html := d.ToHTML()
+
+	if strings.Contains(html, `id="DashboardSidebar"`) {
+		t.Error("expected no sidebar for the offcanvas menu type")
+	}
+
+	if body := regexp.MustCompile(`<body[^>]*>`).FindString(html); strings.Contains(body, "sidebar-collapse") {
+		t.Error("expected no collapsed state for the offcanvas menu type")
+	}
+}
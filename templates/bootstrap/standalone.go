@@ -0,0 +1,42 @@
+package bootstrap
+
+import (
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// standalonePage adds the auth or error layout to the webpage: the logo, a
+// centred card with the content and the footer links, without the navigation
+func standalonePage(webpage *hb.HtmlWebpage, dashboard types.DashboardInterface) string {
+	container := hb.Div().Class("w-100").Style("max-width:420px;")
+
+	if logo := shared.AuthLogo(dashboard); logo != nil {
+		container.Child(hb.Div().Class("text-center mb-4").Child(logo))
+	}
+
+	container.Child(hb.Div().Class("card shadow-sm").
+		Child(hb.Div().Class("card-body p-4").Children(shared.AuthContent(dashboard))))
+
+	if footer := shared.AuthFooter(dashboard, "text-body-secondary"); footer != nil {
+		container.Child(footer)
+	}
+
+	webpage.Body().Child(shared.SkipLink(dashboard, "visually-hidden-focusable"))
+
+	// Add the environment ribbon
+	if ribbon := shared.EnvironmentRibbon(dashboard); ribbon != nil {
+		webpage.Body().Child(ribbon)
+	}
+
+	webpage.Body().Child(shared.MainContent(container).
+		Class("d-flex align-items-center justify-content-center min-vh-100 p-3 bg-body-tertiary"))
+
+	// Add the body end region
+	if region := shared.Region(dashboard, dashboardshared.REGION_BODY_END); region != nil {
+		webpage.Body().Child(region)
+	}
+
+	return webpage.ToHTML()
+}
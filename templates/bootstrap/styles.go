@@ -4,3 +4,24 @@ package bootstrap
 func templateStyle() string {
 	return `html, body{ height: 100%; }`
 }
+
+// sidebarStyle returns the CSS of the sidebar menu type. On large screens
+// the sidebar stays fixed next to the content, shrinks to an icon-only
+// rail in the rail mode and is hidden when collapsed
+func sidebarStyle() string {
+	return `
+.dashboard-sidebar .sidebar-icon{ display:inline-block; width:1.5rem; text-align:center; margin-inline-end:.5rem; flex-shrink:0; }
+.dashboard-sidebar .nav-link{ color:inherit; white-space:nowrap; }
+.dashboard-main{ min-width:0; min-height:100vh; }
+@media (min-width: 992px) {
+	.dashboard-sidebar{ position:sticky; top:0; height:100vh; width:16rem; flex-shrink:0; overflow-y:auto; }
+	.dashboard-sidebar .offcanvas-body{ padding:1rem .5rem; flex-direction:column; }
+	.dashboard-sidebar.sidebar-rail{ width:4.5rem; }
+	.dashboard-sidebar.sidebar-rail .sidebar-text{ display:none; }
+	.dashboard-sidebar.sidebar-rail .sidebar-icon{ margin-inline-end:0; }
+	.dashboard-sidebar.sidebar-rail .nav-link{ justify-content:center; }
+	.dashboard-sidebar.sidebar-rail .collapse{ margin-inline-start:0 !important; }
+	body.sidebar-collapse .dashboard-sidebar{ display:none !important; }
+}
+`
+}
@@ -2,6 +2,7 @@ package bootstrap
 
 import (
 	"github.com/dracory/cdn"
+	dashboardshared "github.com/dracory/dashboard/shared"
 	"github.com/dracory/dashboard/templates/shared"
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
@@ -16,10 +17,34 @@ var _ types.TemplateInterface = (*Template)(nil)
 
 // layout generates the main layout structure for the dashboard
 func (t *Template) layout(dashboard types.DashboardInterface) string {
-	content := dashboard.GetContent()
+	content := shared.MainContent()
+
+	// Bootstrap has no page header, the page header end region is shown
+	// at the top of the content, aligned to the end
+	if region := shared.Region(dashboard, dashboardshared.REGION_PAGE_HEADER_END); region != nil {
+		content.Child(region.Class("d-flex justify-content-end align-items-center gap-2 p-2"))
+	}
+	if region := shared.Region(dashboard, dashboardshared.REGION_CONTENT_BEFORE); region != nil {
+		content.Child(region)
+	}
+	if widgets := shared.Widgets(dashboard); widgets != nil {
+		content.Child(hb.Div().Class("container-fluid pt-3").Child(widgets))
+	}
+	content.Child(shared.FragmentPlaceholder(dashboard, shared.FRAGMENT_CONTENT))
+	if region := shared.Region(dashboard, dashboardshared.REGION_CONTENT_AFTER); region != nil {
+		content.Child(region)
+	}
+
+	if isMenuTypeSidebar(dashboard) {
+		return sidebarLayout(dashboard, content)
+	}
+
 	layout := hb.NewBorderLayout()
 	layout.AddTop(hb.Raw(topNavigation(dashboard)), hb.BORDER_LAYOUT_ALIGN_LEFT, hb.BORDER_LAYOUT_ALIGN_MIDDLE)
-	layout.AddCenter(hb.Raw(content), hb.BORDER_LAYOUT_ALIGN_LEFT, hb.BORDER_LAYOUT_ALIGN_TOP)
+	layout.AddCenter(content, hb.BORDER_LAYOUT_ALIGN_LEFT, hb.BORDER_LAYOUT_ALIGN_TOP)
+	if footer := footer(dashboard); footer != nil {
+		layout.AddBottom(footer, hb.BORDER_LAYOUT_ALIGN_LEFT, hb.BORDER_LAYOUT_ALIGN_BOTTOM)
+	}
 	return layout.ToHTML()
 }
 
@@ -44,26 +69,10 @@ func (t *Template) getStylesAndScripts(dashboard types.DashboardInterface) (
 		scripts = append(scripts, script)
 	}
 
-	// Add theme CSS or default Bootstrap CSS
-	themeName := dashboard.GetTheme()
-	themeName = themeNameVerifyAndFix(themeName)
-
-	if themeName != "" && themeName != THEME_DEFAULT {
-		// Check if it's a known theme
-		_, isLightTheme := themesLight[themeName]
-		_, isDarkTheme := themesDark[themeName]
-
-		if isLightTheme || isDarkTheme {
-			// Use Bootswatch theme
-			styleURLs = append(styleURLs, "https://cdn.jsdelivr.net/npm/bootswatch@5.3.2/dist/"+themeName+"/bootstrap.min.css")
-		} else {
-			// Fallback to default Bootstrap
-			styleURLs = append(styleURLs, cdn.BootstrapCss_5_3_3())
-		}
-	} else {
-		// Use default Bootstrap
-		styleURLs = append(styleURLs, cdn.BootstrapCss_5_3_3())
-	}
+	// Add theme CSS (unknown themes fall back to the default Bootstrap CSS)
+	themeName := themeNameVerifyAndFix(dashboard.GetTheme())
+	theme := themeFromID(themeName)
+	styleURLs = append(styleURLs, lo.Ternary(dashboard.IsRTL(), theme.StylesheetURLRTL, theme.StylesheetURL))
 
 	// Add Bootstrap Icons
 	styleURLs = append(styleURLs, cdn.BootstrapIconsCss_1_11_3())
@@ -71,6 +80,39 @@ func (t *Template) getStylesAndScripts(dashboard types.DashboardInterface) (
 	// Add Bootstrap JS Bundle with Popper
 	scriptURLs = append(scriptURLs, cdn.BootstrapJs_5_3_3())
 
+	// Add sidebar CSS and the JavaScript saving the sidebar state
+	if isMenuTypeSidebar(dashboard) {
+		styles = append(styles, sidebarStyle())
+		if script := sidebarStateScript(dashboard.GetSidebarHandlerUrl()); script != "" {
+			scripts = append(scripts, script)
+		}
+	}
+
+	// Add search JavaScript
+	if script := shared.SearchScript(dashboard.GetSearchHandlerUrl(), dashboard.Translate(dashboardshared.MESSAGE_SEARCH_NO_RESULTS)); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add the favicon tint of the environment
+	if script := shared.EnvironmentFaviconScript(dashboard); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add the session timeout JavaScript
+	if script := shared.SessionTimeoutScript(dashboard); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add command palette JavaScript
+	if script := shared.CommandPaletteScript(dashboard); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add brand theme CSS custom properties, before the custom styles
+	if brand, ok := shared.BrandThemeActive(dashboard); ok {
+		styles = append(styles, brandThemeStyle(brand))
+	}
+
 	styleURLs = append(styleURLs, dashboard.GetStyleURLs()...)
 	scriptURLs = append(scriptURLs, dashboard.GetScriptURLs()...)
 
@@ -91,12 +133,19 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 	// Create a new webpage
 	webpage := hb.Webpage()
 
-	// Set the page title
-	webpage.SetTitle(dashboard.GetTitle())
+	// Set the page title and language
+	webpage.SetTitle(shared.EnvironmentTitle(dashboard))
+	webpage.SetLanguage(dashboard.GetLocale())
+	webpage.Attr("dir", dashboard.GetDirection())
 
 	// Add favicon
 	webpage.SetFavicon(favicon)
 
+	// Use the Bootstrap dark color mode for dark brand themes
+	if brand, ok := shared.BrandThemeActive(dashboard); ok && brand.IsDark {
+		webpage.Attr("data-bs-theme", "dark")
+	}
+
 	// Add CSS URLs
 	for _, styleURL := range styleURLs {
 		webpage.AddStyleURL(styleURL)
@@ -117,12 +166,50 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 		webpage.AddScript(script)
 	}
 
+	// The auth and error page layouts replace the navigation of the dashboard
+	if shared.IsStandaloneLayout(dashboard) {
+		return standalonePage(webpage, dashboard)
+	}
+
+	// Add the skip link, the first stop of keyboard users
+	webpage.Body().Child(shared.SkipLink(dashboard, "visually-hidden-focusable"))
+
+	// Add the environment ribbon, in the corner of the page
+	if ribbon := shared.EnvironmentRibbon(dashboard); ribbon != nil {
+		webpage.Body().Child(ribbon)
+	}
+
+	// Add the impersonation banner, above the navbar
+	if banner := shared.ImpersonationBanner(dashboard); banner != nil {
+		webpage.Body().Child(banner)
+	}
+
 	// Generate the layout
 	layoutHTML := t.layout(dashboard)
 
 	// Add the layout to the webpage
 	webpage.Body().Child(hb.Raw(layoutHTML))
 
+	// Add the command palette if enabled
+	if modal := commandPaletteModal(dashboard); modal != nil {
+		webpage.Body().Child(modal)
+	}
+
+	// Add the session timeout modal if the session expiry is set
+	if modal := shared.SessionTimeoutModal(dashboard, "bs-"); modal != nil {
+		webpage.Body().Child(modal)
+	}
+
+	// Add the body end region, after the layout and the modals
+	if region := shared.Region(dashboard, dashboardshared.REGION_BODY_END); region != nil {
+		webpage.Body().Child(region)
+	}
+
+	// Restore the stored sidebar state before the first paint
+	if isMenuTypeSidebar(dashboard) && dashboard.GetSidebarCollapsed() {
+		webpage.Body().Class("sidebar-collapse")
+	}
+
 	// Handle redirect if needed
 	if redirectURL := dashboard.GetRedirectUrl(); redirectURL != "" {
 		redirectTime := "0"
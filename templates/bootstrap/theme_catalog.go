@@ -0,0 +1,96 @@
+package bootstrap
+
+import (
+	"sort"
+
+	"github.com/dracory/cdn"
+	"github.com/dracory/dashboard/types"
+)
+
+// Ensure Template implements the ThemeCatalogInterface
+var _ types.ThemeCatalogInterface = (*Template)(nil)
+
+// themePreviewColors maps theme identifiers to their primary and background colors
+var themePreviewColors = map[string][]string{
+	THEME_DEFAULT:   {"#0d6efd", "#ffffff"},
+	THEME_CERULEAN:  {"#2fa4e7", "#ffffff"},
+	THEME_COSMO:     {"#2780e3", "#ffffff"},
+	THEME_FLATLY:    {"#2c3e50", "#ffffff"},
+	THEME_JOURNAL:   {"#eb6864", "#ffffff"},
+	THEME_LITERA:    {"#4582ec", "#ffffff"},
+	THEME_LUMEN:     {"#158cba", "#ffffff"},
+	THEME_LUX:       {"#1a1a1a", "#ffffff"},
+	THEME_MATERIA:   {"#2196f3", "#ffffff"},
+	THEME_MINTY:     {"#78c2ad", "#ffffff"},
+	THEME_MORPH:     {"#378dfc", "#d9e3f1"},
+	THEME_PULSE:     {"#593196", "#ffffff"},
+	THEME_QUARTZ:    {"#e83283", "#686dc3"},
+	THEME_SANDSTONE: {"#325d88", "#ffffff"},
+	THEME_SIMPLEX:   {"#d9230f", "#fcfcfc"},
+	THEME_SKETCHY:   {"#333333", "#ffffff"},
+	THEME_SPACELAB:  {"#446e9b", "#ffffff"},
+	THEME_UNITED:    {"#e95420", "#ffffff"},
+	THEME_YETI:      {"#008cba", "#ffffff"},
+	THEME_ZEPHYR:    {"#3459e6", "#ffffff"},
+	THEME_CYBORG:    {"#2a9fd6", "#060606"},
+	THEME_DARKLY:    {"#375a7f", "#222222"},
+	THEME_SLATE:     {"#3a3f44", "#272b30"},
+	THEME_SOLAR:     {"#b58900", "#002b36"},
+	THEME_SUPERHERO: {"#df6919", "#0f2537"},
+	THEME_VAPOR:     {"#6f42c1", "#1a0933"},
+}
+
+// Themes returns the default Bootstrap theme followed by the light
+// and then the dark Bootswatch themes, each group sorted by name
+func (t *Template) Themes() []types.Theme {
+	themes := []types.Theme{themeFromID(THEME_DEFAULT)}
+
+	lightIDs := make([]string, 0, len(themesLight))
+	for id := range themesLight {
+		if id != THEME_DEFAULT {
+			lightIDs = append(lightIDs, id)
+		}
+	}
+	sort.Strings(lightIDs)
+
+	darkIDs := make([]string, 0, len(themesDark))
+	for id := range themesDark {
+		darkIDs = append(darkIDs, id)
+	}
+	sort.Strings(darkIDs)
+
+	for _, id := range append(lightIDs, darkIDs...) {
+		themes = append(themes, themeFromID(id))
+	}
+
+	return themes
+}
+
+// ThemeDefault returns the ID of the default Bootstrap theme
+func (t *Template) ThemeDefault() string {
+	return THEME_DEFAULT
+}
+
+// themeFromID builds the catalog entry for a known theme identifier
+func themeFromID(id string) types.Theme {
+	name, isDark := themesDark[id]
+	if !isDark {
+		name = themesLight[id]
+	}
+
+	stylesheetURL := "https://cdn.jsdelivr.net/npm/bootswatch@5.3.2/dist/" + id + "/bootstrap.min.css"
+	stylesheetURLRTL := "https://cdn.jsdelivr.net/npm/bootswatch@5.3.2/dist/" + id + "/bootstrap.rtl.min.css"
+	if id == THEME_DEFAULT {
+		stylesheetURL = cdn.BootstrapCss_5_3_3()
+		stylesheetURLRTL = "https://cdn.jsdelivr.net/npm/bootstrap@5.3.3/dist/css/bootstrap.rtl.min.css"
+	}
+
+	return types.Theme{
+		ID:               id,
+		Name:             name,
+		IsDark:           isDark,
+		PreviewColors:    themePreviewColors[id],
+		StylesheetURL:    stylesheetURL,
+		StylesheetURLRTL: stylesheetURLRTL,
+	}
+}
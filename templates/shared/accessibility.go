@@ -0,0 +1,46 @@
+package shared
+
+import (
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// MAIN_CONTENT_ID is the ID of the main landmark of the templates,
+// the target of the skip link
+const MAIN_CONTENT_ID = "DashboardContent"
+
+// SkipLink returns the link skipping the navigation to the main content.
+// It is hidden until focused, so it is the first stop of keyboard users.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//   - hiddenClass: the class hiding the link until focused, e.g.
+//     "visually-hidden-focusable" for Bootstrap 5 or
+//     "sr-only sr-only-focusable" for Bootstrap 4
+//
+// Returns:
+//   - *hb.Tag: the link, to be added as the first child of the body
+func SkipLink(dashboard types.DashboardInterface, hiddenClass string) *hb.Tag {
+	return hb.Hyperlink().
+		Class(hiddenClass + " btn btn-light").
+		Href("#" + MAIN_CONTENT_ID).
+		Style("position:absolute;top:.5rem;inset-inline-start:.5rem;z-index:2000;").
+		Text(dashboard.Translate(dashboardshared.MESSAGE_SKIP_TO_CONTENT))
+}
+
+// MainContent returns the main landmark holding the content of the page,
+// focusable by the skip link.
+//
+// Parameters:
+//   - children: the content of the page
+//
+// Returns:
+//   - *hb.Tag: the main element
+func MainContent(children ...hb.TagInterface) *hb.Tag {
+	return hb.Main().
+		ID(MAIN_CONTENT_ID).
+		Attr("tabindex", "-1").
+		Style("outline:none;").
+		Children(children)
+}
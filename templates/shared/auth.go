@@ -0,0 +1,119 @@
+package shared
+
+import (
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// IsStandaloneLayout returns whether the page uses the auth or the error
+// layout, a centred card replacing the navigation of the dashboard
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - bool: true for the auth and error page layouts
+func IsStandaloneLayout(dashboard types.DashboardInterface) bool {
+	return dashboard.GetPageLayout() == dashboardshared.PAGE_LAYOUT_AUTH ||
+		dashboard.GetPageLayout() == dashboardshared.PAGE_LAYOUT_ERROR
+}
+
+// AuthLogo returns the logo of the auth layout, the raw logo HTML or the
+// logo image, linked to the logo redirect URL
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - *hb.Tag: the logo link, or nil when the dashboard has no logo
+func AuthLogo(dashboard types.DashboardInterface) *hb.Tag {
+	link := hb.Hyperlink().
+		Href(lo.Ternary(dashboard.GetLogoRedirectURL() != "", dashboard.GetLogoRedirectURL(), "/"))
+
+	switch {
+	case dashboard.GetLogoRawHtml() != "":
+		return link.
+			Attr("aria-label", dashboard.Translate(dashboardshared.MESSAGE_HOME)).
+			Child(hb.Raw(string(dashboard.GetLogoRawHtml())))
+	case dashboard.GetLogoImageURL() != "":
+		return link.Child(hb.Img(dashboard.GetLogoImageURL()).
+			Alt(dashboard.Translate(dashboardshared.MESSAGE_HOME)).
+			Style("max-height:48px;width:auto;"))
+	}
+
+	return nil
+}
+
+// AuthContent returns the content of the card of the auth layout: the
+// title, the alerts and the content between the content before and after
+// regions. The markup is the same for Bootstrap 4 and 5 and Tabler.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - []hb.TagInterface: the children of the card body
+func AuthContent(dashboard types.DashboardInterface) []hb.TagInterface {
+	children := []hb.TagInterface{}
+
+	if title := dashboard.GetTitle(); title != "" {
+		children = append(children, hb.Heading1().Class("h4 text-center mb-4").Text(title))
+	}
+
+	for _, alert := range dashboard.GetAlerts() {
+		children = append(children, hb.Div().
+			Class("alert alert-"+alert.Type).
+			Role("alert").
+			Text(alert.Message))
+	}
+
+	if region := Region(dashboard, dashboardshared.REGION_CONTENT_BEFORE); region != nil {
+		children = append(children, region)
+	}
+	children = append(children, FragmentPlaceholder(dashboard, FRAGMENT_CONTENT))
+	if region := Region(dashboard, dashboardshared.REGION_CONTENT_AFTER); region != nil {
+		children = append(children, region)
+	}
+
+	return children
+}
+
+// AuthFooter returns the footer of the auth layout, the links of the
+// footer columns followed by the copyright
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//   - mutedClass: the class of the muted text, e.g. "text-body-secondary"
+//     for Bootstrap 5 or "text-muted" for Bootstrap 4
+//
+// Returns:
+//   - *hb.Tag: the footer, or nil when there are no links nor copyright
+func AuthFooter(dashboard types.DashboardInterface, mutedClass string) *hb.Tag {
+	footer := dashboard.GetFooter()
+	if footer == nil {
+		return nil
+	}
+
+	links := hb.Div().Class("d-flex flex-wrap justify-content-center")
+	hasLinks := false
+	for _, column := range footer.Columns {
+		for _, item := range column.Links {
+			links.Child(hb.Hyperlink().
+				Class("mx-2 " + mutedClass).
+				Href(item.URL).
+				Text(item.Title))
+			hasLinks = true
+		}
+	}
+
+	if !hasLinks && footer.Copyright == "" {
+		return nil
+	}
+
+	return hb.Footer().
+		Class("text-center small mt-3 "+mutedClass).
+		ChildIf(hasLinks, links).
+		ChildIf(footer.Copyright != "", hb.Div().Class("mt-2").Text(footer.Copyright))
+}
@@ -0,0 +1,83 @@
+package shared
+
+import (
+	"strconv"
+
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// userStatusColors are the colors of the status dots
+var userStatusColors = map[string]string{
+	dashboardshared.USER_STATUS_ONLINE:  "#2fb344",
+	dashboardshared.USER_STATUS_AWAY:    "#f59f00",
+	dashboardshared.USER_STATUS_BUSY:    "#d63939",
+	dashboardshared.USER_STATUS_OFFLINE: "#adb5bd",
+}
+
+// userStatusMessages are the message keys of the labels of the statuses
+var userStatusMessages = map[string]string{
+	dashboardshared.USER_STATUS_ONLINE:  dashboardshared.MESSAGE_STATUS_ONLINE,
+	dashboardshared.USER_STATUS_AWAY:    dashboardshared.MESSAGE_STATUS_AWAY,
+	dashboardshared.USER_STATUS_BUSY:    dashboardshared.MESSAGE_STATUS_BUSY,
+	dashboardshared.USER_STATUS_OFFLINE: dashboardshared.MESSAGE_STATUS_OFFLINE,
+}
+
+// UserAvatar returns the avatar of the user: the avatar image, the
+// Gravatar or the generated initials avatar, with the status dot. The
+// markup is styled inline, so the avatar looks the same in all the
+// templates. The image is hidden from screen readers, the name is shown
+// next to the avatar, the status dot is labelled.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//   - user: the user
+//   - size: the size of the avatar, in pixels
+//   - class: extra classes of the avatar, e.g. "user-image" for AdminLTE
+//
+// Returns:
+//   - *hb.Tag: the avatar
+func UserAvatar(dashboard types.DashboardInterface, user types.User, size int, class string) *hb.Tag {
+	pixels := strconv.Itoa(size) + "px"
+
+	avatar := hb.Span().
+		Class("dashboard-avatar").
+		ClassIf(class != "", class).
+		Style("position:relative;display:inline-flex;flex-shrink:0;vertical-align:middle;width:" + pixels + ";height:" + pixels + ";")
+
+	url := user.AvatarImageURL(size * 2)
+	generated := url == ""
+	if generated {
+		url = AvatarDataURI(user, size, dashboardshared.AVATAR_SHAPE_CIRCLE)
+	}
+
+	avatar.Child(hb.Img(url).
+		Class("dashboard-avatar-image").
+		ClassIf(generated, "dashboard-avatar-initials").
+		Attr("aria-hidden", "true").
+		Style("width:100%;height:100%;border-radius:50%;object-fit:cover;"))
+
+	if user.Status != "" {
+		color, known := userStatusColors[user.Status]
+		if !known {
+			color = userStatusColors[dashboardshared.USER_STATUS_OFFLINE]
+		}
+
+		label := user.Status
+		if key, ok := userStatusMessages[user.Status]; ok {
+			label = dashboard.Translate(key)
+		}
+
+		dot := strconv.Itoa(max(size/4, 8)) + "px"
+		avatar.Child(hb.Span().
+			Class("dashboard-avatar-status dashboard-avatar-status-"+user.Status).
+			Role("img").
+			Attr("aria-label", label).
+			Attr("title", label).
+			Style("position:absolute;bottom:0;inset-inline-end:0;border-radius:50%;border:2px solid #fff;" +
+				"background:" + color + ";width:" + dot + ";height:" + dot + ";"))
+	}
+
+	return avatar
+}
@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"encoding/base64"
+	"hash/fnv"
+	"html"
+	"strconv"
+	"strings"
+
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// AVATAR_SIZE_DEFAULT is the size of the generated avatars, when no size
+// is given
+const AVATAR_SIZE_DEFAULT = 64
+
+// AVATAR_SIZE_MAX is the largest size of the generated avatars
+const AVATAR_SIZE_MAX = 512
+
+// avatarColors are the background colors of the generated avatars, all
+// dark enough for white initials
+var avatarColors = []string{
+	"#206bc4", // blue
+	"#4263eb", // indigo
+	"#ae3ec9", // purple
+	"#d6336c", // pink
+	"#d63939", // red
+	"#e8590c", // orange
+	"#b67e00", // yellow
+	"#2f9e44", // green
+	"#0c8599", // teal
+	"#1971c2", // azure
+	"#5f3dc4", // violet
+	"#495057", // gray
+}
+
+// AvatarColor returns the background color of the generated avatar of the
+// user, hashed from the ID, or from the email or the name when the user
+// has no ID, so a user keeps the same color on every page
+//
+// Parameters:
+//   - user: the user
+//
+// Returns:
+//   - string: the hex color, e.g. "#206bc4"
+func AvatarColor(user types.User) string {
+	seed := user.ID
+	if seed == "" {
+		seed = strings.ToLower(strings.TrimSpace(user.Email))
+	}
+	if seed == "" {
+		seed = user.Name()
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(seed))
+
+	return avatarColors[hash.Sum32()%uint32(len(avatarColors))]
+}
+
+// AvatarSVG returns the generated avatar of the user, the initials of the
+// user in white on the color of the user
+//
+// Parameters:
+//   - user: the user
+//   - size: the size of the avatar, in pixels, AVATAR_SIZE_DEFAULT when not
+//     positive, at most AVATAR_SIZE_MAX
+//   - shape: the shape of the avatar, one of the AVATAR_SHAPE constants,
+//     a circle when empty or unknown
+//
+// Returns:
+//   - string: the SVG document
+func AvatarSVG(user types.User, size int, shape string) string {
+	if size <= 0 {
+		size = AVATAR_SIZE_DEFAULT
+	}
+	size = min(size, AVATAR_SIZE_MAX)
+
+	radius := size / 2
+	switch shape {
+	case dashboardshared.AVATAR_SHAPE_SQUARE:
+		radius = 0
+	case dashboardshared.AVATAR_SHAPE_ROUNDED:
+		radius = size / 6
+	}
+
+	pixels := strconv.Itoa(size)
+	initials := html.EscapeString(user.Initials())
+
+	return `<svg xmlns="http://www.w3.org/2000/svg" width="` + pixels + `" height="` + pixels + `" viewBox="0 0 ` + pixels + ` ` + pixels + `">` +
+		`<rect width="` + pixels + `" height="` + pixels + `" rx="` + strconv.Itoa(radius) + `" fill="` + AvatarColor(user) + `"/>` +
+		`<text x="50%" y="50%" dy=".35em" fill="#fff" text-anchor="middle" font-weight="600" font-size="` + strconv.Itoa(size*2/5) + `"` +
+		` font-family="system-ui,-apple-system,'Segoe UI',Roboto,'Helvetica Neue',Arial,sans-serif">` + initials + `</text>` +
+		`</svg>`
+}
+
+// AvatarDataURI returns the generated avatar of the user as a data URI,
+// to be used as the source of an image
+//
+// Parameters:
+//   - user: the user
+//   - size: the size of the avatar, in pixels
+//   - shape: the shape of the avatar, one of the AVATAR_SHAPE constants
+//
+// Returns:
+//   - string: the data URI of the SVG
+func AvatarDataURI(user types.User, size int, shape string) string {
+	return "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString([]byte(AvatarSVG(user, size, shape)))
+}
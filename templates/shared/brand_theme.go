@@ -0,0 +1,150 @@
+package shared
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dracory/dashboard/types"
+	"github.com/samber/lo"
+)
+
+// brandThemeValuePattern matches the font families and border radii
+// accepted in the style block, without the characters ending the
+// declaration, the rule or the style element
+var brandThemeValuePattern = regexp.MustCompile(`^[^<>{};\\\x00-\x1f]*$`)
+
+// BrandThemeActive returns the brand theme matching the active theme of the
+// dashboard, with the design tokens validated by BrandThemeSanitize.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - types.BrandTheme: the active brand theme
+//   - bool: whether the active theme is a brand theme
+func BrandThemeActive(dashboard types.DashboardInterface) (types.BrandTheme, bool) {
+	themeName := dashboard.GetTheme()
+	brand, ok := lo.Find(dashboard.GetBrandThemes(), func(brand types.BrandTheme) bool {
+		return brand.ID == themeName
+	})
+	return BrandThemeSanitize(brand), ok
+}
+
+// BrandThemeSanitize clears the design tokens which cannot be copied into
+// the style block as they are. The colors must be hex, named, rgb() or
+// hsl() colors, like the colors of the environment indicator. The font
+// family and the border radius must not contain <, >, {, }, ; nor a
+// backslash.
+//
+// Parameters:
+//   - brand: the brand theme
+//
+// Returns:
+//   - types.BrandTheme: the brand theme, with the invalid tokens cleared
+func BrandThemeSanitize(brand types.BrandTheme) types.BrandTheme {
+	for _, color := range []*string{&brand.PrimaryColor, &brand.SecondaryColor, &brand.SuccessColor, &brand.DangerColor} {
+		if *color != "" && !environmentColorPattern.MatchString(*color) {
+			*color = ""
+		}
+	}
+
+	for _, value := range []*string{&brand.FontFamily, &brand.BorderRadius} {
+		if !brandThemeValuePattern.MatchString(*value) {
+			*value = ""
+		}
+	}
+
+	return brand
+}
+
+// HexToRGB converts a hex color to the comma separated "r, g, b" form
+// used by the *-rgb CSS custom properties.
+//
+// Parameters:
+//   - hex: the color, e.g. "#0055aa" or "#05a"
+//
+// Returns:
+//   - string: the RGB triplet, e.g. "0, 85, 170"
+//   - bool: whether the color could be converted
+func HexToRGB(hex string) (string, bool) {
+	cleaned := strings.TrimPrefix(hex, "#")
+	if len(cleaned) == 3 {
+		cleaned = string([]byte{cleaned[0], cleaned[0], cleaned[1], cleaned[1], cleaned[2], cleaned[2]})
+	}
+
+	if len(cleaned) != 6 {
+		return "", false
+	}
+
+	value, err := strconv.ParseUint(cleaned, 16, 32)
+	if err != nil {
+		return "", false
+	}
+
+	r := strconv.Itoa(int((value >> 16) & 0xFF))
+	g := strconv.Itoa(int((value >> 8) & 0xFF))
+	b := strconv.Itoa(int(value & 0xFF))
+
+	return r + ", " + g + ", " + b, true
+}
+
+// Spacing scales a CSS length given in rem by the spacing scale of a brand theme.
+//
+// Parameters:
+//   - rem: the default length in rem
+//   - scale: the spacing scale (0 keeps the default)
+//
+// Returns:
+//   - string: the scaled CSS length, e.g. "1.25rem"
+func Spacing(rem float64, scale float64) string {
+	if scale > 0 {
+		rem = rem * scale
+	}
+	return strconv.FormatFloat(rem, 'f', -1, 64) + "rem"
+}
+
+// CSSRule builds a CSS rule from the non-empty declarations.
+//
+// Parameters:
+//   - selector: the CSS selector
+//   - declarations: property and value pairs, empty values are skipped
+//
+// Returns:
+//   - string: the CSS rule, or an empty string if there are no declarations
+func CSSRule(selector string, declarations [][2]string) string {
+	lines := []string{}
+	for _, declaration := range declarations {
+		if declaration[1] == "" {
+			continue
+		}
+		lines = append(lines, "\t"+declaration[0]+": "+declaration[1]+";")
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return selector + " {\n" + strings.Join(lines, "\n") + "\n}\n"
+}
+
+// BrandThemeColors returns the theme color names (primary, secondary,
+// success, danger) with the colors set in the brand theme.
+//
+// Parameters:
+//   - brand: the brand theme
+//
+// Returns:
+//   - [][2]string: color name and color pairs, colors not set are skipped
+func BrandThemeColors(brand types.BrandTheme) [][2]string {
+	colors := [][2]string{
+		{"primary", brand.PrimaryColor},
+		{"secondary", brand.SecondaryColor},
+		{"success", brand.SuccessColor},
+		{"danger", brand.DangerColor},
+	}
+
+	return lo.Filter(colors, func(color [2]string, _ int) bool {
+		return color[1] != ""
+	})
+}
@@ -0,0 +1,301 @@
+package shared
+
+import (
+	"encoding/json"
+
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// commandPathSeparator separates the titles of nested menu items
+const commandPathSeparator = " › "
+
+// CommandPaletteCommands returns the commands indexed by the command palette:
+// the main, user and quick access menu items at all nesting levels, the
+// actions, and the extra commands added to the dashboard.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - []types.Command: the commands, in display order
+func CommandPaletteCommands(dashboard types.DashboardInterface) []types.Command {
+	commands := []types.Command{}
+
+	commands = commandsFromMenuItems(commands, dashboard.GetMenuMainItems(), dashboard.Translate(dashboardshared.MESSAGE_MENU), "")
+	commands = commandsFromMenuItems(commands, dashboard.GetMenuQuickAccessItems(), dashboard.Translate(dashboardshared.MESSAGE_QUICK_ACCESS), "")
+	commands = commandsFromMenuItems(commands, dashboard.GetMenuUserItems(), dashboard.Translate(dashboardshared.MESSAGE_USER), "")
+
+	for _, action := range dashboard.GetActions() {
+		if action.Title == "" || action.OnClick == "" {
+			continue
+		}
+
+		commands = append(commands, types.Command{
+			Title:    action.Title,
+			OnClick:  action.OnClick,
+			Category: dashboard.Translate(dashboardshared.MESSAGE_ACTIONS),
+		})
+	}
+
+	for _, command := range dashboard.GetCommands() {
+		if command.Category == "" {
+			command.Category = dashboard.Translate(dashboardshared.MESSAGE_COMMANDS)
+		}
+		commands = append(commands, command)
+	}
+
+	return commands
+}
+
+// commandsFromMenuItems appends the menu items with a URL, and their
+// children, to the commands. Nested items are titled with their full path
+func commandsFromMenuItems(commands []types.Command, items []types.MenuItem, category, path string) []types.Command {
+	for _, item := range items {
+		if item.Title == "" {
+			continue
+		}
+
+		title := item.Title
+		if path != "" {
+			title = path + commandPathSeparator + item.Title
+		}
+
+		if item.URL != "" && item.URL != "#" {
+			commands = append(commands, types.Command{
+				Title:    title,
+				URL:      item.URL,
+				Target:   item.Target,
+				Icon:     item.Icon,
+				Category: category,
+			})
+		}
+
+		commands = commandsFromMenuItems(commands, item.Children, category, title)
+	}
+
+	return commands
+}
+
+// CommandPaletteScript returns the client script of the command palette.
+// It opens the palette on Ctrl+K (Cmd+K on macOS), fuzzy matches the
+// commands while the user types and runs the selected command.
+//
+// The templates render the palette as a modal with the ID CommandPalette,
+// containing an input with the data-command-palette-input attribute and a
+// list group with the data-command-palette-results attribute. The modal is
+// opened with Bootstrap 5, or with the jQuery plugin of Bootstrap 4.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - string: the JavaScript, or an empty string when the palette is disabled
+func CommandPaletteScript(dashboard types.DashboardInterface) string {
+	if !dashboard.GetCommandPaletteEnabled() {
+		return ""
+	}
+
+	commands, _ := json.Marshal(CommandPaletteCommands(dashboard))
+	noResults, _ := json.Marshal(dashboard.Translate(dashboardshared.MESSAGE_COMMAND_NO_RESULTS))
+
+	return `
+document.addEventListener('DOMContentLoaded', function() {
+	var commands = ` + string(commands) + `;
+	var noResults = ` + string(noResults) + `;
+	var modal = document.getElementById('CommandPalette');
+	if (!modal) {
+		return;
+	}
+
+	var input = modal.querySelector('[data-command-palette-input]');
+	var list = modal.querySelector('[data-command-palette-results]');
+	var matches = [];
+	var selected = 0;
+
+	// fuzzyScore returns 0 when the query does not match the text, otherwise
+	// a score which favours substrings, consecutive letters and word starts
+	function fuzzyScore(query, text) {
+		query = query.toLowerCase();
+		text = text.toLowerCase();
+
+		var substring = text.indexOf(query);
+		if (substring !== -1) {
+			return 1000 - substring;
+		}
+
+		var score = 0;
+		var from = 0;
+		var previous = -2;
+		for (var i = 0; i < query.length; i++) {
+			var letter = query.charAt(i);
+			if (letter === ' ') {
+				continue;
+			}
+
+			var found = text.indexOf(letter, from);
+			if (found === -1) {
+				return 0;
+			}
+
+			score += found === previous + 1 ? 5 : 1;
+			if (found === 0 || ' ›/-_'.indexOf(text.charAt(found - 1)) !== -1) {
+				score += 3;
+			}
+
+			previous = found;
+			from = found + 1;
+		}
+
+		return score;
+	}
+
+	function search(query) {
+		query = query.trim();
+		if (query === '') {
+			return commands.slice(0, 50);
+		}
+
+		return commands
+			.map(function(command) {
+				var text = [command.title, command.category || ''].concat(command.keywords || []).join(' ');
+				return { command: command, score: fuzzyScore(query, text) };
+			})
+			.filter(function(match) { return match.score > 0; })
+			.sort(function(a, b) { return b.score - a.score; })
+			.slice(0, 50)
+			.map(function(match) { return match.command; });
+	}
+
+	function render() {
+		list.innerHTML = '';
+
+		if (matches.length === 0) {
+			var empty = document.createElement('div');
+			empty.className = 'list-group-item text-muted';
+			empty.textContent = noResults;
+			list.appendChild(empty);
+			return;
+		}
+
+		matches.forEach(function(command, index) {
+			var item = document.createElement('a');
+			item.className = 'list-group-item list-group-item-action d-flex align-items-center' + (index === selected ? ' active' : '');
+			item.href = command.url || '#';
+			item.setAttribute('role', 'option');
+			item.setAttribute('aria-selected', index === selected ? 'true' : 'false');
+
+			if (command.icon) {
+				var icon = document.createElement('span');
+				icon.style.marginInlineEnd = '0.5rem';
+				if (command.icon.charAt(0) === '<') {
+					icon.innerHTML = command.icon;
+				} else {
+					var i = document.createElement('i');
+					i.className = command.icon;
+					icon.appendChild(i);
+				}
+				item.appendChild(icon);
+			}
+
+			item.appendChild(document.createTextNode(command.title));
+
+			if (command.category) {
+				var category = document.createElement('small');
+				category.className = 'text-muted';
+				category.style.marginInlineStart = 'auto';
+				category.style.paddingInlineStart = '1rem';
+				category.textContent = command.category;
+				item.appendChild(category);
+			}
+
+			item.addEventListener('click', function(event) {
+				event.preventDefault();
+				run(command);
+			});
+
+			list.appendChild(item);
+		});
+
+		var active = list.querySelector('.active');
+		if (active) {
+			active.scrollIntoView({ block: 'nearest' });
+		}
+	}
+
+	function update() {
+		matches = search(input.value);
+		selected = 0;
+		render();
+	}
+
+	function open() {
+		input.value = '';
+		update();
+
+		if (window.bootstrap && bootstrap.Modal) {
+			bootstrap.Modal.getOrCreateInstance(modal).show();
+		} else if (window.jQuery && jQuery.fn.modal) {
+			jQuery(modal).modal('show');
+		}
+
+		setTimeout(function() { input.focus(); }, 200);
+	}
+
+	function close() {
+		if (window.bootstrap && bootstrap.Modal) {
+			bootstrap.Modal.getOrCreateInstance(modal).hide();
+		} else if (window.jQuery && jQuery.fn.modal) {
+			jQuery(modal).modal('hide');
+		}
+	}
+
+	function run(command) {
+		close();
+
+		if (command.onclick) {
+			new Function(command.onclick)();
+		} else if (command.url) {
+			if (command.target && command.target !== '_self') {
+				window.open(command.url, command.target);
+			} else {
+				window.location.href = command.url;
+			}
+		}
+	}
+
+	document.addEventListener('keydown', function(event) {
+		if ((event.ctrlKey || event.metaKey) && (event.key === 'k' || event.key === 'K')) {
+			event.preventDefault();
+			open();
+		}
+	});
+
+	document.querySelectorAll('[data-command-palette-open]').forEach(function(button) {
+		button.addEventListener('click', function(event) {
+			event.preventDefault();
+			open();
+		});
+	});
+
+	input.addEventListener('input', update);
+
+	input.addEventListener('keydown', function(event) {
+		if (event.key === 'ArrowDown') {
+			event.preventDefault();
+			selected = Math.min(selected + 1, matches.length - 1);
+			render();
+		} else if (event.key === 'ArrowUp') {
+			event.preventDefault();
+			selected = Math.max(selected - 1, 0);
+			render();
+		} else if (event.key === 'Enter') {
+			event.preventDefault();
+			if (matches[selected]) {
+				run(matches[selected]);
+			}
+		}
+	});
+});
+`
+}
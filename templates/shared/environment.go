@@ -0,0 +1,185 @@
+package shared
+
+import (
+	"encoding/json"
+	"html"
+	"regexp"
+	"strings"
+
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// environmentColors are the default colors of the environment indicator,
+// by lower case environment name
+var environmentColors = map[string]string{
+	"production":  "#d63939",
+	"prod":        "#d63939",
+	"live":        "#d63939",
+	"staging":     "#f76707",
+	"stage":       "#f76707",
+	"uat":         "#f76707",
+	"preview":     "#f76707",
+	"test":        "#206bc4",
+	"testing":     "#206bc4",
+	"qa":          "#206bc4",
+	"development": "#2f9e44",
+	"dev":         "#2f9e44",
+	"local":       "#2f9e44",
+}
+
+// environmentColorDefault is the color of the other environments
+const environmentColorDefault = "#6c757d"
+
+// environmentColorPattern matches the colors accepted in the inline styles:
+// hex colors, color names and rgb() or hsl() colors
+var environmentColorPattern = regexp.MustCompile(`^(#[0-9a-fA-F]{3,8}|[a-zA-Z]+|(rgb|hsl)a?\([0-9.,%\s]+\))$`)
+
+// EnvironmentColor returns the color of the environment indicator, the
+// color of the environment when valid, or else the default color of the
+// environment name
+//
+// Parameters:
+//   - environment: the environment
+//
+// Returns:
+//   - string: the CSS color
+func EnvironmentColor(environment types.Environment) string {
+	if environmentColorPattern.MatchString(environment.Color) {
+		return environment.Color
+	}
+
+	if color, ok := environmentColors[strings.ToLower(environment.Name)]; ok {
+		return color
+	}
+
+	return environmentColorDefault
+}
+
+// EnvironmentRibbon returns the corner ribbon naming the environment, in
+// the top end corner of the page. The ribbon does not catch the clicks.
+// The standalone layouts have no navbar, they show the ribbon for the
+// badge display too.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - *hb.Tag: the ribbon, or nil when there is no environment or the
+//     environment is shown as a navbar badge
+func EnvironmentRibbon(dashboard types.DashboardInterface) *hb.Tag {
+	environment := dashboard.GetEnvironment()
+	if environment == nil {
+		return nil
+	}
+
+	if environment.Display == dashboardshared.ENVIRONMENT_DISPLAY_BADGE && !IsStandaloneLayout(dashboard) {
+		return nil
+	}
+
+	corner := "right:-48px;transform:rotate(45deg);"
+	if dashboard.IsRTL() {
+		corner = "left:-48px;transform:rotate(-45deg);"
+	}
+
+	return hb.Div().
+		Class("dashboard-environment dashboard-environment-ribbon").
+		Style("position:fixed;top:24px;z-index:1090;width:180px;padding:4px 0;pointer-events:none;" +
+			"text-align:center;text-transform:uppercase;font-size:12px;font-weight:700;letter-spacing:.05em;" +
+			"color:#fff;box-shadow:0 1px 4px rgba(0,0,0,.3);" + corner +
+			"background:" + EnvironmentColor(*environment) + ";").
+		Text(environment.Name)
+}
+
+// EnvironmentBadge returns the navbar badge naming the environment, styled
+// inline so it looks the same in all the templates
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - *hb.Tag: the badge, or nil when there is no environment or the
+//     environment is shown as a ribbon
+func EnvironmentBadge(dashboard types.DashboardInterface) *hb.Tag {
+	environment := dashboard.GetEnvironment()
+	if environment == nil || environment.Display != dashboardshared.ENVIRONMENT_DISPLAY_BADGE {
+		return nil
+	}
+
+	return hb.Span().
+		Class("badge dashboard-environment dashboard-environment-badge").
+		Style("text-transform:uppercase;color:#fff;background:" + EnvironmentColor(*environment) + ";").
+		Text(environment.Name)
+}
+
+// EnvironmentTitle returns the HTML escaped title of the page, prefixed
+// with the environment name, e.g. "[STAGING] Orders"
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - string: the escaped title
+func EnvironmentTitle(dashboard types.DashboardInterface) string {
+	environment := dashboard.GetEnvironment()
+	if environment == nil {
+		return html.EscapeString(dashboard.GetTitle())
+	}
+
+	return html.EscapeString(strings.TrimSpace("[" + strings.ToUpper(environment.Name) + "] " + dashboard.GetTitle()))
+}
+
+// EnvironmentFaviconScript returns the JavaScript tinting the favicon with
+// the color of the environment, with a bar along the bottom edge. The
+// favicons of other origins cannot be read, they are left as they are.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - string: the JavaScript, or an empty string when there is no environment
+func EnvironmentFaviconScript(dashboard types.DashboardInterface) string {
+	environment := dashboard.GetEnvironment()
+	if environment == nil {
+		return ""
+	}
+
+	color, _ := json.Marshal(EnvironmentColor(*environment))
+
+	return `
+document.addEventListener('DOMContentLoaded', function() {
+	var color = ` + string(color) + `;
+	var link = document.querySelector('link[rel~="icon"]');
+	if (!link) {
+		return;
+	}
+
+	var image = new Image();
+	image.onload = function() {
+		var size = 32;
+		var canvas = document.createElement('canvas');
+		canvas.width = size;
+		canvas.height = size;
+
+		var context = canvas.getContext('2d');
+		context.drawImage(image, 0, 0, size, size);
+		context.globalCompositeOperation = 'source-atop';
+		context.globalAlpha = 0.4;
+		context.fillStyle = color;
+		context.fillRect(0, 0, size, size);
+		context.globalCompositeOperation = 'source-over';
+		context.globalAlpha = 1;
+		context.fillRect(0, size - 8, size, 8);
+
+		try {
+			link.href = canvas.toDataURL('image/png');
+			link.type = 'image/png';
+		} catch (e) {
+			// The favicon of another origin taints the canvas
+		}
+	};
+	image.src = link.href;
+});
+`
+}
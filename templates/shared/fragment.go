@@ -0,0 +1,89 @@
+package shared
+
+import (
+	"strconv"
+
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// Fragment names, the fragments of the modals, the widgets and the layout
+// regions are numbered by their position, see FragmentName and RegionFragmentName
+const (
+	FRAGMENT_CONTENT      = "content"
+	FRAGMENT_FOOTER       = "footer"
+	FRAGMENT_MODAL_BODY   = "modal-body"
+	FRAGMENT_MODAL_FOOTER = "modal-footer"
+	FRAGMENT_REGION       = "region"
+	FRAGMENT_WIDGET       = "widget"
+)
+
+// FRAGMENT_PLACEHOLDER_PREFIX and FRAGMENT_PLACEHOLDER_SUFFIX enclose the
+// token of the render and the name of the fragment in its placeholder
+const (
+	FRAGMENT_PLACEHOLDER_PREFIX = "<!--dashboard-fragment:"
+	FRAGMENT_PLACEHOLDER_SUFFIX = "-->"
+)
+
+// FragmentName returns the name of a numbered fragment
+//
+// Parameters:
+//   - kind: the kind of fragment, e.g. FRAGMENT_MODAL_BODY
+//   - index: the position of the fragment, e.g. the index of the modal
+//
+// Returns:
+//   - string: the name of the fragment, e.g. "modal-body:2"
+func FragmentName(kind string, index int) string {
+	return kind + ":" + strconv.Itoa(index)
+}
+
+// FragmentPlaceholder returns the placeholder marking where a fragment
+// goes. The templates render the page with placeholders, which are
+// replaced by streaming the fragments when the dashboard is rendered. The
+// placeholder holds the random token of the render, so markup of the
+// application looking like a placeholder is left as it is.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//   - name: the name of the fragment, e.g. FRAGMENT_CONTENT
+//
+// Returns:
+//   - *hb.Tag: the placeholder, e.g. "<!--dashboard-fragment:<token>:content-->"
+func FragmentPlaceholder(dashboard types.DashboardInterface, name string) *hb.Tag {
+	return hb.Raw(FRAGMENT_PLACEHOLDER_PREFIX + dashboard.GetFragmentToken() + ":" + name + FRAGMENT_PLACEHOLDER_SUFFIX)
+}
+
+// RegionFragmentName returns the name of a fragment of a layout region
+//
+// Parameters:
+//   - region: the layout region, e.g. dashboardshared.REGION_NAVBAR_END
+//   - index: the position of the fragment in the region
+//
+// Returns:
+//   - string: the name of the fragment, e.g. "region:navbar-end:0"
+func RegionFragmentName(region string, index int) string {
+	return FragmentName(FRAGMENT_REGION+":"+region, index)
+}
+
+// Region returns the container of the fragments of a layout region, with
+// the "dashboard-region" and "dashboard-region-<region>" classes
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//   - region: the layout region, e.g. dashboardshared.REGION_NAVBAR_END
+//
+// Returns:
+//   - *hb.Tag: the container, or nil when the region has no fragments
+func Region(dashboard types.DashboardInterface, region string) *hb.Tag {
+	fragments := dashboard.GetRegionFragments(region)
+	if len(fragments) == 0 {
+		return nil
+	}
+
+	container := hb.Div().Class("dashboard-region dashboard-region-" + region)
+	for index := range fragments {
+		container.Child(FragmentPlaceholder(dashboard, RegionFragmentName(region, index)))
+	}
+
+	return container
+}
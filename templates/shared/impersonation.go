@@ -0,0 +1,96 @@
+package shared
+
+import (
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// ImpersonationBanner returns the banner shown while a real user
+// impersonates the user of the dashboard, naming both users, with the
+// "stop impersonating" button. The markup is the same for Bootstrap 4 and
+// 5 and Tabler.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - *hb.Tag: the banner, or nil when not impersonating
+func ImpersonationBanner(dashboard types.DashboardInterface) *hb.Tag {
+	impersonation := dashboard.GetImpersonation()
+	if impersonation == nil {
+		return nil
+	}
+
+	userName := ""
+	if user := dashboard.GetUser(); user != nil {
+		userName = user.Name()
+	}
+
+	label := dashboard.Translate(dashboardshared.MESSAGE_IMPERSONATING)
+
+	return hb.Div().
+		Class("dashboard-impersonation alert alert-warning rounded-0 border-0 mb-0 py-2 text-center").
+		Role("region").
+		Attr("aria-label", label).
+		Child(hb.Span().
+			Class("mx-2").
+			Text(label+" ").
+			Child(hb.Strong().Text(userName))).
+		ChildIf(ImpersonationSignedInAs(dashboard) != "", hb.Span().
+			Class("mx-2 small").
+			Text(ImpersonationSignedInAs(dashboard))).
+		Child(ImpersonationStopForm(dashboard, "btn btn-sm btn-dark mx-2").Class("d-inline"))
+}
+
+// ImpersonationSignedInAs returns the label naming the real user, shown in
+// the user menus while impersonating, e.g. "Signed in as Jane Doe"
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - string: the label, or an empty string when not impersonating
+func ImpersonationSignedInAs(dashboard types.DashboardInterface) string {
+	impersonation := dashboard.GetImpersonation()
+	if impersonation == nil || impersonation.RealUser.Name() == "" {
+		return ""
+	}
+
+	return dashboard.Translate(dashboardshared.MESSAGE_SIGNED_IN_AS) + " " + impersonation.RealUser.Name()
+}
+
+// ImpersonationStopForm returns the "stop impersonating" button, in a form
+// posted to the stop URL of the impersonation with the CSRF field
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//   - buttonClass: the class of the button, e.g. "dropdown-item" in the
+//     user menus
+//
+// Returns:
+//   - *hb.Tag: the form, or nil when not impersonating
+func ImpersonationStopForm(dashboard types.DashboardInterface, buttonClass string) *hb.Tag {
+	impersonation := dashboard.GetImpersonation()
+	if impersonation == nil {
+		return nil
+	}
+
+	fieldName := lo.Ternary(impersonation.CSRFFieldName != "", impersonation.CSRFFieldName, "csrf_token")
+
+	form := hb.Form().
+		Class("dashboard-impersonation-stop").
+		Method("post")
+
+	if impersonation.StopURL != "" {
+		form.Action(impersonation.StopURL)
+	}
+
+	return form.
+		ChildIf(impersonation.CSRFToken != "", hb.Input().Type("hidden").Name(fieldName).Value(impersonation.CSRFToken)).
+		Child(hb.Button().
+			Type(hb.TYPE_SUBMIT).
+			Class(buttonClass).
+			Text(dashboard.Translate(dashboardshared.MESSAGE_STOP_IMPERSONATING)))
+}
@@ -0,0 +1,194 @@
+package shared
+
+import "encoding/json"
+
+// SearchScript returns the client script of the navbar search box. It
+// queries the search handler while the user types and shows the grouped
+// results in the dropdown of the search box.
+//
+// The templates render the search box as an element with the
+// data-dashboard-search attribute, containing an input named "q" and a
+// dropdown menu with the data-search-results attribute. The results use
+// the dropdown-header and dropdown-item classes, shared by Bootstrap 4 and 5.
+//
+// Parameters:
+//   - searchHandlerUrl: the URL of the search handler
+//   - noResults: the text shown when nothing matches
+//
+// Returns:
+//   - string: the JavaScript, or an empty string when the URL is empty
+func SearchScript(searchHandlerUrl, noResults string) string {
+	if searchHandlerUrl == "" {
+		return ""
+	}
+
+	handlerUrl, _ := json.Marshal(searchHandlerUrl)
+	noResultsText, _ := json.Marshal(noResults)
+
+	return `
+document.addEventListener('DOMContentLoaded', function() {
+	var handlerUrl = ` + string(handlerUrl) + `;
+	var noResults = ` + string(noResultsText) + `;
+
+	// safeUrl returns the URL of a result when it is relative or http(s),
+	// "#" when it is empty, or null for the other schemes, e.g. javascript:
+	function safeUrl(value) {
+		if (!value) {
+			return '#';
+		}
+		try {
+			var url = new URL(value, window.location.href);
+			return url.protocol === 'http:' || url.protocol === 'https:' ? value : null;
+		} catch (e) {
+			return null;
+		}
+	}
+
+	document.querySelectorAll('[data-dashboard-search]').forEach(function(search) {
+		var input = search.querySelector('input[name="q"]');
+		var dropdown = search.querySelector('[data-search-results]');
+		if (!input || !dropdown) {
+			return;
+		}
+
+		var timer = null;
+		var sequence = 0;
+
+		function hide() {
+			dropdown.classList.remove('show');
+			input.setAttribute('aria-expanded', 'false');
+		}
+
+		function show() {
+			dropdown.classList.add('show');
+			input.setAttribute('aria-expanded', 'true');
+		}
+
+		function items() {
+			return Array.prototype.slice.call(dropdown.querySelectorAll('a.dropdown-item'));
+		}
+
+		function render(data) {
+			dropdown.innerHTML = '';
+
+			var groups = (data && data.groups) || [];
+			if (groups.length === 0) {
+				var empty = document.createElement('span');
+				empty.className = 'dropdown-item-text text-muted';
+				empty.textContent = data && data.error ? data.error : noResults;
+				dropdown.appendChild(empty);
+				show();
+				return;
+			}
+
+			groups.forEach(function(group) {
+				if (group.category) {
+					var header = document.createElement('h6');
+					header.className = 'dropdown-header';
+					header.textContent = group.category;
+					dropdown.appendChild(header);
+				}
+
+				(group.results || []).forEach(function(result) {
+					var href = safeUrl(result.url);
+					if (href === null) {
+						return;
+					}
+
+					var link = document.createElement('a');
+					link.className = 'dropdown-item';
+					link.href = href;
+					link.setAttribute('role', 'option');
+
+					if (result.icon) {
+						var icon = document.createElement('i');
+						icon.className = result.icon;
+						icon.style.marginInlineEnd = '0.5rem';
+						link.appendChild(icon);
+					}
+
+					link.appendChild(document.createTextNode(result.title || ''));
+					dropdown.appendChild(link);
+				});
+			});
+
+			show();
+		}
+
+		function query(value) {
+			var current = ++sequence;
+			var url = handlerUrl + (handlerUrl.indexOf('?') === -1 ? '?' : '&') + 'q=' + encodeURIComponent(value);
+
+			fetch(url, { credentials: 'same-origin', headers: { 'Accept': 'application/json' } })
+				.then(function(response) { return response.json(); })
+				.then(function(data) {
+					if (current === sequence && input.value.trim() === value) {
+						render(data);
+					}
+				})
+				.catch(function(e) {
+					console.warn('Error searching:', e);
+				});
+		}
+
+		input.addEventListener('input', function() {
+			clearTimeout(timer);
+			var value = input.value.trim();
+			if (value === '') {
+				sequence++;
+				hide();
+				return;
+			}
+			timer = setTimeout(function() { query(value); }, 200);
+		});
+
+		input.addEventListener('keydown', function(event) {
+			var links = items();
+			var index = links.indexOf(document.activeElement);
+
+			if (event.key === 'ArrowDown' && links.length > 0) {
+				event.preventDefault();
+				links[Math.min(index + 1, links.length - 1)].focus();
+			} else if (event.key === 'Escape') {
+				hide();
+			}
+		});
+
+		dropdown.addEventListener('keydown', function(event) {
+			var links = items();
+			var index = links.indexOf(document.activeElement);
+
+			if (event.key === 'ArrowDown') {
+				event.preventDefault();
+				links[Math.min(index + 1, links.length - 1)].focus();
+			} else if (event.key === 'ArrowUp') {
+				event.preventDefault();
+				if (index <= 0) {
+					input.focus();
+				} else {
+					links[index - 1].focus();
+				}
+			} else if (event.key === 'Escape') {
+				hide();
+				input.focus();
+			}
+		});
+
+		// Enter opens the first result
+		search.addEventListener('submit', function(event) {
+			event.preventDefault();
+			var links = items();
+			if (links.length > 0) {
+				window.location.href = links[0].href;
+			}
+		});
+
+		document.addEventListener('click', function(event) {
+			if (!search.contains(event.target)) {
+				hide();
+			}
+		});
+	});
+});
+`
+}
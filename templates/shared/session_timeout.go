@@ -0,0 +1,254 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// sessionTimeoutWarnBeforeDefault is how long before the expiry the modal
+// is shown, when not set
+const sessionTimeoutWarnBeforeDefault = 2 * time.Minute
+
+// SessionTimeoutWarnBefore returns how long before the expiry of the
+// session the countdown modal is shown
+//
+// Parameters:
+//   - sessionTimeout: the session timeout
+//
+// Returns:
+//   - time.Duration: the warning time, 2 minutes by default
+func SessionTimeoutWarnBefore(sessionTimeout types.SessionTimeout) time.Duration {
+	return lo.Ternary(sessionTimeout.WarnBefore > 0, sessionTimeout.WarnBefore, sessionTimeoutWarnBeforeDefault)
+}
+
+// SessionTimeoutCountdown returns the initial text of the countdown of the
+// modal, the warning time as minutes and seconds, e.g. "2:00"
+//
+// Parameters:
+//   - sessionTimeout: the session timeout
+//
+// Returns:
+//   - string: the countdown text
+func SessionTimeoutCountdown(sessionTimeout types.SessionTimeout) string {
+	seconds := int(SessionTimeoutWarnBefore(sessionTimeout).Seconds())
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// SessionTimeoutModal returns the #SessionTimeout modal counting down to the
+// expiry of the session. The modal can only be closed by staying signed in.
+// The markup is the same for Bootstrap 4 and 5, AdminLTE and Tabler, only
+// the prefix of the data attributes of the modal differs.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//   - dataPrefix: the prefix of the data attributes of the modal, "bs-"
+//     for Bootstrap 5 and Tabler, "" for Bootstrap 4 and AdminLTE
+//
+// Returns:
+//   - *hb.Tag: the modal, or nil without session timeout
+func SessionTimeoutModal(dashboard types.DashboardInterface, dataPrefix string) *hb.Tag {
+	sessionTimeout := dashboard.GetSessionTimeout()
+	if sessionTimeout == nil {
+		return nil
+	}
+
+	content := hb.Div().Class("modal-content").
+		Child(hb.Div().Class("modal-header").
+			Child(hb.H5().ID("SessionTimeoutLabel").Class("modal-title").Text(dashboard.Translate(dashboardshared.MESSAGE_SESSION_EXPIRING)))).
+		Child(hb.Div().Class("modal-body").
+			Child(hb.P().Class("mb-0").
+				Text(dashboard.Translate(dashboardshared.MESSAGE_SESSION_EXPIRING_HELP)+" ").
+				Child(hb.Strong().Data("session-timeout-countdown", "true").Text(SessionTimeoutCountdown(*sessionTimeout))))).
+		ChildIf(sessionTimeout.KeepAliveURL != "", hb.Div().Class("modal-footer").
+			Child(hb.Button().
+				Type(hb.TYPE_BUTTON).
+				Class("btn btn-primary").
+				Data("session-timeout-keep-alive", "true").
+				Text(dashboard.Translate(dashboardshared.MESSAGE_STAY_SIGNED_IN))))
+
+	return hb.Div().
+		ID("SessionTimeout").
+		Class("modal fade").
+		Attr("tabindex", "-1").
+		Role("dialog").
+		Attr("aria-labelledby", "SessionTimeoutLabel").
+		Attr("aria-hidden", "true").
+		Data(dataPrefix+"backdrop", "static").
+		Data(dataPrefix+"keyboard", "false").
+		Child(hb.Div().Class("modal-dialog modal-dialog-centered").Role("document").Child(content))
+}
+
+// SessionTimeoutScript returns the JavaScript of the session timeout: it
+// shows the #SessionTimeout modal when the session is about to expire,
+// counts down, posts the keep-alive request when the user stays signed in
+// and redirects to the login URL when the session expires. The modal is
+// opened with Bootstrap 5, or with the jQuery plugin of Bootstrap 4.
+//
+// The tabs of the dashboard share the session, the expiry is shared between
+// them in the localStorage: a page loaded or the session kept alive in a
+// tab pushes back the expiry in the other tabs, which do not redirect to
+// the login URL while the session is still alive.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - string: the JavaScript, or an empty string without session timeout
+func SessionTimeoutScript(dashboard types.DashboardInterface) string {
+	sessionTimeout := dashboard.GetSessionTimeout()
+	if sessionTimeout == nil {
+		return ""
+	}
+
+	config, _ := json.Marshal(map[string]any{
+		"expiresIn":     max(time.Until(sessionTimeout.ExpiresAt).Milliseconds(), 0),
+		"warnBefore":    SessionTimeoutWarnBefore(*sessionTimeout).Milliseconds(),
+		"keepAliveUrl":  sessionTimeout.KeepAliveURL,
+		"loginUrl":      lo.Ternary(dashboard.GetLoginURL() != "", dashboard.GetLoginURL(), "/login"),
+		"csrfFieldName": lo.Ternary(sessionTimeout.CSRFFieldName != "", sessionTimeout.CSRFFieldName, "csrf_token"),
+		"csrfToken":     sessionTimeout.CSRFToken,
+	})
+
+	return `
+document.addEventListener('DOMContentLoaded', function() {
+	var config = ` + string(config) + `;
+	var modal = document.getElementById('SessionTimeout');
+	if (!modal) {
+		return;
+	}
+
+	var countdown = modal.querySelector('[data-session-timeout-countdown]');
+	var button = modal.querySelector('[data-session-timeout-keep-alive]');
+	var storageKey = 'dashboard-session-deadline';
+	var deadline = Date.now() + config.expiresIn;
+	var shown = false;
+
+	function show() {
+		shown = true;
+		if (window.bootstrap && bootstrap.Modal) {
+			bootstrap.Modal.getOrCreateInstance(modal).show();
+		} else if (window.jQuery && jQuery.fn.modal) {
+			jQuery(modal).modal('show');
+		}
+	}
+
+	function hide() {
+		shown = false;
+		if (window.bootstrap && bootstrap.Modal) {
+			bootstrap.Modal.getOrCreateInstance(modal).hide();
+		} else if (window.jQuery && jQuery.fn.modal) {
+			jQuery(modal).modal('hide');
+		}
+	}
+
+	// load returns the expiry shared by the tabs, or 0 without localStorage
+	function load() {
+		try {
+			return parseInt(window.localStorage.getItem(storageKey), 10) || 0;
+		} catch (e) {
+			return 0;
+		}
+	}
+
+	function save() {
+		try {
+			window.localStorage.setItem(storageKey, String(deadline));
+		} catch (e) {
+			// Without localStorage each tab counts down on its own
+		}
+	}
+
+	function extend(value) {
+		deadline = value;
+		if (shown && deadline - Date.now() > config.warnBefore) {
+			hide();
+		}
+	}
+
+	function expire() {
+		window.clearInterval(timer);
+		window.location.href = config.loginUrl;
+	}
+
+	function tick() {
+		var shared = load();
+		if (shared > deadline) {
+			extend(shared);
+		}
+
+		var remaining = deadline - Date.now();
+		if (remaining <= 0) {
+			expire();
+			return;
+		}
+
+		if (remaining > config.warnBefore) {
+			return;
+		}
+
+		var seconds = Math.ceil(remaining / 1000);
+		if (countdown) {
+			countdown.textContent = Math.floor(seconds / 60) + ':' + ('0' + seconds % 60).slice(-2);
+		}
+		if (!shown) {
+			show();
+		}
+	}
+
+	// Keep the later expiry stored by another tab, tick adopts it
+	if (deadline > load()) {
+		save();
+	}
+	window.addEventListener('storage', function(event) {
+		var value = event.key === storageKey ? parseInt(event.newValue, 10) : 0;
+		if (value > 0) {
+			extend(value);
+		}
+	});
+
+	var timer = window.setInterval(tick, 1000);
+	tick();
+
+	if (!button || !config.keepAliveUrl) {
+		return;
+	}
+
+	button.addEventListener('click', function() {
+		var body = new FormData();
+		if (config.csrfToken) {
+			body.append(config.csrfFieldName, config.csrfToken);
+		}
+
+		button.disabled = true;
+		fetch(config.keepAliveUrl, {
+			method: 'POST',
+			body: body,
+			credentials: 'same-origin',
+			headers: { 'Accept': 'application/json' }
+		}).then(function(response) {
+			if (response.status === 401) {
+				expire();
+				return null;
+			}
+			return response.ok ? response.json() : null;
+		}).then(function(data) {
+			if (data && data.expires_in > 0) {
+				deadline = Date.now() + data.expires_in * 1000;
+				save();
+				hide();
+			}
+		}).catch(function() {
+			// Keep counting down, the user can try again
+		}).finally(function() {
+			button.disabled = false;
+		});
+	});
+});
+`
+}
@@ -0,0 +1,52 @@
+package shared
+
+import (
+	"strconv"
+
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// Widgets returns the grid of the widgets, a row of cards holding the
+// placeholders of the widget bodies. The markup is shared by the
+// templates, as Bootstrap 4 and 5 and Tabler have the same grid and cards.
+//
+// Parameters:
+//   - dashboard: the dashboard being rendered
+//
+// Returns:
+//   - *hb.Tag: the grid, or nil when there are no widgets
+func Widgets(dashboard types.DashboardInterface) *hb.Tag {
+	widgets := dashboard.GetWidgets()
+	if len(widgets) == 0 {
+		return nil
+	}
+
+	row := hb.Div().Class("row dashboard-widgets")
+
+	for index, widget := range widgets {
+		columns := widget.Columns
+		if columns < 1 || columns > 12 {
+			columns = 12
+		}
+
+		card := hb.Div().Class("card h-100")
+		if widget.ID != "" {
+			card.ID(widget.ID)
+		}
+
+		if widget.Title != "" {
+			card.Child(hb.Div().Class("card-header").
+				Child(hb.Heading2().Class("card-title h6 mb-0").Text(widget.Title)))
+		}
+
+		card.Child(hb.Div().Class("card-body").
+			Child(FragmentPlaceholder(dashboard, FragmentName(FRAGMENT_WIDGET, index))))
+
+		row.Child(hb.Div().
+			Class("col-12 col-md-" + strconv.Itoa(columns) + " mb-3").
+			Child(card))
+	}
+
+	return row
+}
@@ -0,0 +1,52 @@
+package tabler
+
+import (
+	"github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+// brandThemeStyle converts a brand theme to Tabler CSS custom properties.
+// Tabler components refer to the --tblr-* theme colors directly,
+// so overriding the root properties is sufficient
+func brandThemeStyle(brand types.BrandTheme) string {
+	root := [][2]string{}
+
+	for _, color := range shared.BrandThemeColors(brand) {
+		name, value := color[0], color[1]
+		root = append(root, [2]string{"--tblr-" + name, value})
+		if rgb, ok := shared.HexToRGB(value); ok {
+			root = append(root, [2]string{"--tblr-" + name + "-rgb", rgb})
+		}
+	}
+
+	if brand.PrimaryColor != "" {
+		root = append(root, [2]string{"--tblr-link-color", brand.PrimaryColor})
+		if rgb, ok := shared.HexToRGB(brand.PrimaryColor); ok {
+			root = append(root, [2]string{"--tblr-link-color-rgb", rgb})
+		}
+	}
+
+	root = append(root,
+		[2]string{"--tblr-font-sans-serif", brand.FontFamily},
+		[2]string{"--tblr-body-font-family", brand.FontFamily},
+		[2]string{"--tblr-border-radius", brand.BorderRadius},
+	)
+
+	if brand.SpacingScale > 0 {
+		root = append(root,
+			[2]string{"--tblr-page-padding", shared.Spacing(1.5, brand.SpacingScale)},
+			[2]string{"--tblr-gutter-x", shared.Spacing(1.5, brand.SpacingScale)},
+		)
+	}
+
+	style := shared.CSSRule(":root, [data-bs-theme]", root)
+
+	if brand.SpacingScale > 0 {
+		style += shared.CSSRule(".card", [][2]string{
+			{"--tblr-card-spacer-y", shared.Spacing(1, brand.SpacingScale)},
+			{"--tblr-card-spacer-x", shared.Spacing(1.25, brand.SpacingScale)},
+		})
+	}
+
+	return style
+}
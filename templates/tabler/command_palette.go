@@ -0,0 +1,48 @@
+package tabler
+
+import (
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// commandPaletteModal creates the modal of the command palette, or nil
+// when the command palette is disabled
+func commandPaletteModal(dashboard types.DashboardInterface) *hb.Tag {
+	if !dashboard.GetCommandPaletteEnabled() {
+		return nil
+	}
+
+	input := hb.NewInput().
+		Type("search").
+		Class("form-control form-control-lg").
+		Placeholder(dashboard.Translate(shared.MESSAGE_COMMAND_PLACEHOLDER)).
+		Attr("autocomplete", "off").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_COMMAND)).
+		Attr("aria-controls", "command-palette-results").
+		Data("command-palette-input", "true")
+
+	results := hb.NewDiv().
+		ID("command-palette-results").
+		Class("list-group list-group-flush").
+		Attr("role", "listbox").
+		Data("command-palette-results", "true")
+
+	content := hb.NewDiv().Class("modal-content").
+		Child(hb.NewDiv().Class("modal-header").
+			Child(hb.NewH5().ID("command-palette-label").Class("visually-hidden").Text(dashboard.Translate(shared.MESSAGE_COMMAND_PALETTE))).
+			Child(hb.NewDiv().Class("input-icon w-100").
+				Child(hb.NewSpan().Class("input-icon-addon").Child(hb.NewI().Class("ti ti-command"))).
+				Child(input))).
+		Child(hb.NewDiv().Class("modal-body p-0").Child(results)).
+		Child(hb.NewDiv().Class("modal-footer text-secondary small").
+			Text(dashboard.Translate(shared.MESSAGE_COMMAND_HELP)))
+
+	return hb.NewDiv().
+		ID("CommandPalette").
+		Class("modal modal-blur fade").
+		Attr("tabindex", "-1").
+		Attr("aria-labelledby", "command-palette-label").
+		Attr("aria-hidden", "true").
+		Child(hb.NewDiv().Class("modal-dialog modal-dialog-scrollable modal-lg").Child(content))
+}
@@ -0,0 +1,67 @@
+package tabler
+
+import (
+	"github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// footer generates the page footer, or nil when the footer is not set
+func footer(dashboard types.DashboardInterface, options layoutOptions) *hb.Tag {
+	model := dashboard.GetFooter()
+	if model == nil {
+		return nil
+	}
+
+	container := hb.NewDiv().Class(options.containerClass())
+
+	// Link columns
+	if len(model.Columns) > 0 {
+		row := hb.NewDiv().Class("row g-3 mb-3")
+		for _, column := range model.Columns {
+			links := hb.NewUL().Class("list-unstyled space-y-1 mb-0")
+			for _, link := range column.Links {
+				links.Child(hb.NewLI().Child(hb.NewA().
+					Class("link-secondary").
+					Href(lo.Ternary(link.URL == "", "#", link.URL)).
+					TargetIf(link.Target != "", link.Target).
+					Text(link.Title)))
+			}
+
+			row.Child(hb.NewDiv().Class("col-6 col-md").
+				ChildIf(column.Title != "", hb.NewDiv().Class("subheader mb-2").Text(column.Title)).
+				Child(links))
+		}
+		container.Child(row)
+	}
+
+	// Copyright on the left, version and environment on the right
+	meta := hb.NewUL().Class("list-inline list-inline-dots mb-0")
+	if model.Environment != "" {
+		meta.Child(hb.NewLI().Class("list-inline-item").
+			Child(hb.NewSpan().Class("badge bg-yellow-lt").Text(model.Environment)))
+	}
+	if model.Version != "" {
+		meta.Child(hb.NewLI().Class("list-inline-item").Text(model.Version))
+	}
+
+	copyright := hb.NewUL().Class("list-inline list-inline-dots mb-0")
+	if model.Copyright != "" {
+		copyright.Child(hb.NewLI().Class("list-inline-item").Text(model.Copyright))
+	}
+
+	container.Child(hb.NewDiv().
+		Class("row text-center align-items-center flex-row-reverse").
+		Child(hb.NewDiv().Class("col-lg-auto ms-lg-auto").Child(meta)).
+		Child(hb.NewDiv().Class("col-12 col-lg-auto mt-3 mt-lg-0").Child(copyright)))
+
+	// Custom HTML
+	if model.HTML != "" || model.Fragment != nil {
+		container.Child(hb.NewDiv().Class("mt-3").Child(shared.FragmentPlaceholder(dashboard, shared.FRAGMENT_FOOTER)))
+	}
+
+	return hb.NewFooter().
+		Class("footer footer-transparent d-print-none").
+		Child(container)
+}
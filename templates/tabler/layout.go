@@ -0,0 +1,50 @@
+package tabler
+
+import "github.com/dracory/dashboard/shared"
+
+// layoutOptions describes the structural features of a Tabler layout
+type layoutOptions struct {
+	vertical   bool // main menu in a vertical sidebar
+	right      bool // sidebar on the right side
+	condensed  bool // single header with brand and main menu
+	combined   bool // vertical sidebar plus a horizontal top menu
+	fluid      bool // full width containers
+	boxed      bool // page boxed in the middle of the screen
+	navbarDark bool // dark navbar
+	overlap    bool // dark navbar overlapping the page header
+}
+
+// layoutOptionsFromName returns the layout options for the layout name,
+// unknown layout names fall back to the horizontal layout
+func layoutOptionsFromName(layout string) layoutOptions {
+	switch layout {
+	case shared.TEMPLATE_TABLER_LAYOUT_VERTICAL:
+		return layoutOptions{vertical: true}
+	case shared.TEMPLATE_TABLER_LAYOUT_VERTICAL_RIGHT:
+		return layoutOptions{vertical: true, right: true}
+	case shared.TEMPLATE_TABLER_LAYOUT_CONDENSED:
+		return layoutOptions{condensed: true}
+	case shared.TEMPLATE_TABLER_LAYOUT_COMBINED:
+		return layoutOptions{vertical: true, combined: true}
+	case shared.TEMPLATE_TABLER_LAYOUT_FLUID:
+		return layoutOptions{fluid: true}
+	case shared.TEMPLATE_TABLER_LAYOUT_FLUID_VERTICAL:
+		return layoutOptions{vertical: true, fluid: true}
+	case shared.TEMPLATE_TABLER_LAYOUT_BOXED:
+		return layoutOptions{boxed: true}
+	case shared.TEMPLATE_TABLER_LAYOUT_NAVBAR_DARK:
+		return layoutOptions{navbarDark: true}
+	case shared.TEMPLATE_TABLER_LAYOUT_NAVBAR_OVERLAP:
+		return layoutOptions{condensed: true, navbarDark: true, overlap: true}
+	default:
+		return layoutOptions{}
+	}
+}
+
+// containerClass returns the container class used throughout the layout
+func (o layoutOptions) containerClass() string {
+	if o.fluid {
+		return "container-fluid"
+	}
+	return "container-xl"
+}
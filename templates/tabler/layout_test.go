@@ -0,0 +1,151 @@
+package tabler_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func renderLayout(layout string) string {
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_TABLER)
+	d.SetTablerConfig(types.TablerConfig{Layout: layout})
+	d.SetTitle("Layout Test")
+	d.SetUser(types.User{FirstName: "Jane", LastName: "Doe"})
+	d.SetMenuMainItems([]types.MenuItem{
+		{Title: "Home", URL: "/"},
+		{Title: "Users", URL: "/users"},
+	})
+	d.SetMenuQuickAccessItems([]types.MenuItem{
+		{Title: "New Order", URL: "/orders/new"},
+	})
+	return d.ToHTML()
+}
+
+func TestLayouts(t *testing.T) {
+	tests := []struct {
+		layout     string
+		contains   []string
+		notContain []string
+		bodyClass  string
+	}{
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_HORIZONTAL,
+			contains: []string{
+				`<header class="navbar navbar-expand-md d-print-none">`,
+				`<div class="navbar navbar-expand-md">`,
+				`<nav aria-label="Main navigation" class="collapse navbar-collapse" id="navbar-menu">`,
+				`<main class="page-body" id="DashboardContent" style="outline:none;" tabindex="-1"><div class="container-xl">`,
+			},
+			notContain: []string{`<aside`, `container-fluid`},
+		},
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_VERTICAL,
+			contains: []string{
+				`<aside aria-label="Menu" class="navbar navbar-vertical navbar-expand-lg" data-bs-theme="dark">`,
+				`<nav aria-label="Main navigation" class="collapse navbar-collapse" id="sidebar-menu">`,
+				`<header class="navbar navbar-expand-md d-print-none d-none d-lg-flex">`,
+				`data-sidebar-toggle="true"`,
+			},
+			notContain: []string{`navbar-right`, `id="navbar-menu"`},
+		},
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_VERTICAL_RIGHT,
+			contains: []string{
+				`<aside aria-label="Menu" class="navbar navbar-vertical navbar-expand-lg navbar-right" data-bs-theme="dark">`,
+				`id="sidebar-menu"`,
+			},
+		},
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_CONDENSED,
+			contains: []string{
+				`<header class="navbar navbar-expand-md d-print-none">`,
+				`<nav aria-label="Main navigation" class="collapse navbar-collapse" id="navbar-menu"><div class="d-flex flex-column flex-md-row flex-fill align-items-stretch align-items-md-center">`,
+			},
+			notContain: []string{`<div class="navbar navbar-expand-md">`, `<aside`, `navbar-overlap`},
+		},
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_COMBINED,
+			contains: []string{
+				`<aside aria-label="Menu" class="navbar navbar-vertical navbar-expand-lg" data-bs-theme="dark">`,
+				`<header class="navbar navbar-expand-md d-print-none">`,
+				`href="/orders/new"`,
+			},
+			notContain: []string{`d-none d-lg-flex">`},
+		},
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_FLUID,
+			contains: []string{
+				`<main class="page-body" id="DashboardContent" style="outline:none;" tabindex="-1"><div class="container-fluid">`,
+			},
+			notContain: []string{`container-xl`, `<aside`},
+			bodyClass:  "layout-fluid",
+		},
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_FLUID_VERTICAL,
+			contains: []string{
+				`navbar-vertical`,
+				`<main class="page-body" id="DashboardContent" style="outline:none;" tabindex="-1"><div class="container-fluid">`,
+			},
+			notContain: []string{`container-xl`},
+			bodyClass:  "layout-fluid",
+		},
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_BOXED,
+			contains: []string{
+				`<main class="page-body" id="DashboardContent" style="outline:none;" tabindex="-1"><div class="container-xl">`,
+			},
+			bodyClass: "layout-boxed",
+		},
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_NAVBAR_DARK,
+			contains: []string{
+				`<header class="navbar navbar-expand-md d-print-none navbar-dark" data-bs-theme="dark">`,
+				`<div class="navbar navbar-expand-md navbar-dark" data-bs-theme="dark">`,
+			},
+		},
+		{
+			layout: shared.TEMPLATE_TABLER_LAYOUT_NAVBAR_OVERLAP,
+			contains: []string{
+				`<header class="navbar navbar-expand-md d-print-none navbar-overlap navbar-dark" data-bs-theme="dark">`,
+				`<div class="page-header d-print-none text-white" data-bs-theme="dark">`,
+			},
+			notContain: []string{`<div class="navbar navbar-expand-md`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			html := renderLayout(tt.layout)
+
+			for _, expected := range tt.contains {
+				if !strings.Contains(html, expected) {
+					t.Errorf("expected markup %q", expected)
+				}
+			}
+
+			for _, unexpected := range tt.notContain {
+				if strings.Contains(html, unexpected) {
+					t.Errorf("unexpected markup %q", unexpected)
+				}
+			}
+
+			body := regexp.MustCompile(`<body[^>]*>`).FindString(html)
+			if tt.bodyClass != "" && !strings.Contains(body, tt.bodyClass) {
+				t.Errorf("expected body class %q, got %s", tt.bodyClass, body)
+			}
+		})
+	}
+}
+
+func TestLayoutDefaultsToHorizontal(t *testing.T) {
+	for _, layout := range []string{"", "unknown"} {
+		if renderLayout(layout) != renderLayout(shared.TEMPLATE_TABLER_LAYOUT_HORIZONTAL) {
+			t.Errorf("expected layout %q to render as horizontal", layout)
+		}
+	}
+}
@@ -1,25 +1,47 @@
 package tabler
 
 import (
+	"net/url"
+	"strings"
+
+	"github.com/dracory/dashboard/shared"
+	templatesshared "github.com/dracory/dashboard/templates/shared"
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
 	"github.com/samber/lo"
 )
 
-// topNavigation generates the top navigation bar with two headers
-func topNavigation(dashboard types.DashboardInterface) string {
-	header1 := navbarHeader1(dashboard)
-	header2 := navbarHeader2(dashboard)
+// topNavigation generates the top navigation bar for the layout:
+// two headers (brand and main menu) for horizontal layouts, a single
+// header for the condensed layouts and a top bar for vertical layouts
+func topNavigation(dashboard types.DashboardInterface, options layoutOptions) string {
+	if options.condensed {
+		return navbarCondensed(dashboard, options).ToHTML()
+	}
+
+	header1 := navbarHeader1(dashboard, options)
+
+	if options.vertical {
+		return header1.ToHTML()
+	}
+
+	header2 := navbarHeader2(dashboard, options)
 
 	// Wrap both headers in a parent container
 	return hb.Wrap(header1, header2).ToHTML()
 }
 
-// navbarHeader1 creates the first header with logo and user menu
-func navbarHeader1(dashboard types.DashboardInterface) *hb.Tag {
+// navbarRightNav creates the right side of the navbar with the theme and user menus
+func navbarRightNav(dashboard types.DashboardInterface) *hb.Tag {
 	dropdownTheme := themedropdown(dashboard)
 
 	rightNav := navbarRightContainer()
+	if search := navbarSearch(dashboard); search != nil {
+		rightNav = rightNav.Child(search)
+	}
+	if region := templatesshared.Region(dashboard, shared.REGION_NAVBAR_END); region != nil {
+		rightNav = rightNav.Child(region.Class("nav-item d-flex align-items-center gap-2 me-3"))
+	}
 	if dropdownTheme != nil {
 		dropdownTheme.AddClass("nav-link px-0")
 		rightNav = rightNav.Child(hb.Div().
@@ -27,48 +49,121 @@ func navbarHeader1(dashboard types.DashboardInterface) *hb.Tag {
 			Child(dropdownTheme))
 	}
 
-	rightNav = rightNav.Child(lo.TernaryF(dashboard.GetUser() != nil,
+	return rightNav.Child(lo.TernaryF(dashboard.GetUser() != nil,
 		func() *hb.Tag { return navbarUserMenu(dashboard, dashboard.GetUser()) },
-		func() *hb.Tag { return navbarLoginButton() }))
+		func() *hb.Tag { return navbarLoginButton(dashboard) }))
+}
+
+// navbarThemeClass adds the dark navbar classes if required by the layout or the dashboard
+func navbarThemeClass(header *hb.Tag, dashboard types.DashboardInterface, options layoutOptions) *hb.Tag {
+	if dashboard.GetNavbarBackgroundColorMode() == "dark" || options.navbarDark {
+		header.AddClass("navbar-dark")
+		header.Attr("data-bs-theme", "dark")
+	}
+	return header
+}
+
+// navbarHeader1 creates the first header with logo and user menu.
+// In vertical layouts the logo lives in the sidebar, so the header
+// becomes a top bar with the sidebar toggle, shown on large screens only
+func navbarHeader1(dashboard types.DashboardInterface, options layoutOptions) *hb.Tag {
+	container := navbarContainer(options)
+
+	if options.vertical {
+		container.Child(sidebarToggle(dashboard))
+	} else {
+		container.Child(navbarButtonToggler(dashboard, "#navbar-menu")).
+			Child(navbarBrand(dashboard))
+	}
+
+	if badge := templatesshared.EnvironmentBadge(dashboard); badge != nil {
+		container.Child(badge.Class("ms-3"))
+	}
+
+	if region := navbarStartRegion(dashboard); region != nil {
+		container.Child(region)
+	}
+
+	// Combined layout shows the quick access items as a horizontal menu
+	if options.combined {
+		container.Child(hb.Nav().
+			Class("collapse navbar-collapse").
+			ID("navbar-menu").
+			Attr("aria-label", dashboard.Translate(shared.MESSAGE_QUICK_ACCESS)).
+			Child(buildNavigation(dashboard.GetMenuQuickAccessItems())))
+	}
+
+	container.Child(hb.Div().
+		Class("d-flex ms-auto").
+		Child(navbarRightNav(dashboard)))
 
-	headerClass := "navbar-expand-md"
-	if dashboard.GetNavbarBackgroundColorMode() == "dark" {
-		headerClass += " navbar-dark"
+	header := navbarHeader().Class("navbar-expand-md")
+	if options.vertical && !options.combined {
+		header.AddClass("d-none d-lg-flex")
 	}
 
-	return navbarHeader().
-		Class(headerClass).
-		Child(navbarContainer().
-			Child(navbarButtonToggler()).
-			Child(navbarBrand(dashboard)).
-			Child(hb.Div().
-				Class("d-flex ms-auto").
-				Child(rightNav)))
+	return navbarThemeClass(header, dashboard, options).Child(container)
 }
 
 // navbarHeader2 creates the second navbar with main navigation menu
-func navbarHeader2(dashboard types.DashboardInterface) *hb.Tag {
+func navbarHeader2(dashboard types.DashboardInterface, options layoutOptions) *hb.Tag {
 	nav := buildNavigation(dashboard.GetMenuMainItems())
 
-	headerClass := "navbar navbar-expand-md"
-	if dashboard.GetNavbarBackgroundColorMode() == "dark" {
-		headerClass += " navbar-dark"
-	}
-
-	container := hb.Div().Class("container-xl")
-	navMenu := navMenuContainer()
+	container := hb.Div().Class(options.containerClass())
+	navMenu := navMenuContainer(dashboard)
 	navContainer := hb.Div().Class("navbar-nav flex-row").Child(nav)
 
-	return hb.Div().
-		Class(headerClass).
+	header := hb.Div().Class("navbar navbar-expand-md")
+
+	return navbarThemeClass(header, dashboard, options).
 		Child(container.Child(navMenu.Child(navContainer)))
 }
 
+// navbarCondensed creates a single header with the logo, the main menu
+// and the user menu, overlapping the page header in the overlap layout
+func navbarCondensed(dashboard types.DashboardInterface, options layoutOptions) *hb.Tag {
+	navMenu := navMenuContainer(dashboard).Child(hb.Div().
+		Class("d-flex flex-column flex-md-row flex-fill align-items-stretch align-items-md-center").
+		Child(buildNavigation(dashboard.GetMenuMainItems())))
+
+	header := navbarHeader().
+		Class("navbar-expand-md").
+		ClassIf(options.overlap, "navbar-overlap")
+
+	container := navbarContainer(options).
+		Child(navbarButtonToggler(dashboard, "#navbar-menu")).
+		Child(navbarBrand(dashboard))
+
+	if badge := templatesshared.EnvironmentBadge(dashboard); badge != nil {
+		container.Child(badge.Class("ms-3"))
+	}
+
+	if region := navbarStartRegion(dashboard); region != nil {
+		container.Child(region)
+	}
+
+	return navbarThemeClass(header, dashboard, options).
+		Child(container.
+			Child(navbarRightNav(dashboard)).
+			Child(navMenu))
+}
+
+// navbarStartRegion creates the navbar-start region, shown after the
+// brand, or nil when the region is empty
+func navbarStartRegion(dashboard types.DashboardInterface) *hb.Tag {
+	region := templatesshared.Region(dashboard, shared.REGION_NAVBAR_START)
+	if region == nil {
+		return nil
+	}
+	return region.Class("d-flex align-items-center gap-2 ms-3")
+}
+
 // navMenuContainer creates the main navigation menu container
-func navMenuContainer() *hb.Tag {
-	return hb.Div().
+func navMenuContainer(dashboard types.DashboardInterface) *hb.Tag {
+	return hb.Nav().
 		Class("collapse navbar-collapse").
-		Attr("id", "navbar-menu")
+		Attr("id", "navbar-menu").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_MAIN_NAVIGATION))
 }
 
 // navList creates the main navigation list
@@ -92,13 +187,13 @@ func navLink(item types.MenuItem) *hb.Tag {
 
 	// Add active class if the item is active
 	if item.IsActive {
-		link.AddClass("active")
+		link.AddClass("active").Attr("aria-current", "page")
 	}
 
 	// Add icon if present
 	if item.Icon != "" {
-		icon := hb.Span().Class("nav-link-icon")
-		icon.Child(hb.Raw(item.Icon))
+		icon := hb.Span().Class("nav-link-icon").Attr("aria-hidden", "true")
+		icon.Child(hb.Raw(string(item.Icon)))
 		link.Child(icon)
 	}
 
@@ -183,30 +278,36 @@ func navbarHeader() *hb.Tag {
 }
 
 // navbarContainer creates the main container for navbar content
-func navbarContainer() *hb.Tag {
+func navbarContainer(options layoutOptions) *hb.Tag {
 	return hb.Div().
-		Class("container-xl")
+		Class(options.containerClass())
 }
 
 // navbarBrand creates the brand/logo section
 func navbarBrand(dashboard types.DashboardInterface) *hb.Tag {
 	brand := hb.Div().
 		Class("navbar-brand navbar-brand-autodark d-none-navbar-horizontal pe-0 pe-md-3")
-	brandLink := hb.A().Href(".").Attr("aria-label", "Tabler")
+	return brand.Child(navbarBrandLink(dashboard))
+}
+
+// navbarBrandLink creates the logo link
+func navbarBrandLink(dashboard types.DashboardInterface) *hb.Tag {
+	brandLink := hb.A().Href(".")
 
 	// Set logo or title
 	switch {
 	case dashboard.GetLogoImageURL() != "":
-		brandLink.Child(hb.Img(dashboard.GetLogoImageURL()).Class("navbar-brand-image"))
+		brandLink.Child(hb.Img(dashboard.GetLogoImageURL()).Class("navbar-brand-image").Alt(dashboard.Translate(shared.MESSAGE_HOME)))
 	case dashboard.GetLogoRawHtml() != "":
-		brandLink.Child(hb.Raw(dashboard.GetLogoRawHtml()))
+		brandLink.Attr("aria-label", dashboard.Translate(shared.MESSAGE_HOME))
+		brandLink.Child(hb.Raw(string(dashboard.GetLogoRawHtml())))
 	default:
 		brandLink.Child(hb.Span().Text(dashboard.GetTitle()))
 	}
 
 	// Set redirect URL
 	brandLink.Attr("href", lo.Ternary(dashboard.GetLogoRedirectURL() != "", dashboard.GetLogoRedirectURL(), "#"))
-	return brand.Child(brandLink)
+	return brandLink
 }
 
 // navbarRightContainer creates the right-aligned navigation container
@@ -214,16 +315,16 @@ func navbarRightContainer() *hb.Tag {
 	return hb.NewDiv().Class("navbar-nav flex-row order-md-last")
 }
 
-// navbarButtonToggler creates the mobile menu toggle button
-func navbarButtonToggler() *hb.Tag {
+// navbarButtonToggler creates the mobile menu toggle button for the target menu
+func navbarButtonToggler(dashboard types.DashboardInterface, target string) *hb.Tag {
 	toggleBtn := hb.Button().
 		Class("navbar-toggler").
 		Type("button").
 		Data("bs-toggle", "collapse").
-		Data("bs-target", "#navbar-menu").
-		Aria("controls", "navbar-menu").
+		Data("bs-target", target).
+		Aria("controls", strings.TrimPrefix(target, "#")).
 		Aria("expanded", "false").
-		Aria("label", "Toggle navigation").
+		Aria("label", dashboard.Translate(shared.MESSAGE_TOGGLE_NAVIGATION)).
 		Child(hb.Span().
 			Class("navbar-toggler-icon"))
 	return toggleBtn
@@ -233,35 +334,32 @@ func navbarButtonToggler() *hb.Tag {
 func navbarUserMenu(dashboard types.DashboardInterface, user *types.User) *hb.Tag {
 	userMenu := hb.Div().Class("nav-item dropdown")
 	userLink := hb.A().
+		Href("#").
 		Class("nav-link d-flex lh-1 text-reset p-0").
-		Attr("data-bs-toggle", "dropdown")
-
-	// User avatar with initials
-	initials := ""
-	if len(user.FirstName) > 0 {
-		initials = string(user.FirstName[0])
-	}
+		Attr("data-bs-toggle", "dropdown").
+		Attr("role", "button").
+		Attr("aria-expanded", "false")
 
-	avatar := hb.Span().
-		Class("avatar avatar-sm").
-		Child(hb.Span().
-			Class("avatar-initials").
-			Text(initials))
+	// User avatar, the image, the Gravatar or the initials, with the status
+	avatar := templatesshared.UserAvatar(dashboard, *user, 32, "")
 
-	// User info
+	// User info, the job title or the email below the name
 	userInfo := hb.Div().Class("d-none d-xl-block ps-2")
-	userInfo.Child(hb.Div().Text(user.FirstName + " " + user.LastName))
+	userInfo.Child(hb.Div().Text(user.Name()))
 
-	if user.Email != "" {
+	if details := lo.Ternary(user.JobTitle != "", user.JobTitle, user.Email); details != "" {
 		userInfo.Child(hb.Div().
 			Class("mt-1 small text-muted").
-			Text(user.Email))
+			Text(details))
 	}
 
 	userLink.Children([]hb.TagInterface{avatar, userInfo})
 
-	// Dropdown menu
+	// Dropdown menu, naming the real user while impersonating
 	dropdownMenu := hb.Div().Class("dropdown-menu dropdown-menu-end dropdown-menu-arrow")
+	if signedInAs := templatesshared.ImpersonationSignedInAs(dashboard); signedInAs != "" {
+		dropdownMenu.Child(hb.Div().Class("dropdown-header text-warning").Text(signedInAs))
+	}
 	for _, item := range dashboard.GetMenuUserItems() {
 		if item.URL == "" && item.Title == "" {
 			dropdownMenu.Child(hb.Div().Class("dropdown-divider"))
@@ -274,22 +372,28 @@ func navbarUserMenu(dashboard types.DashboardInterface, user *types.User) *hb.Ta
 			Attr("target", item.Target)
 
 		if item.Icon != "" {
-			link.Child(hb.Raw(item.Icon)).AddClass("me-2")
+			link.Child(hb.Raw(string(item.Icon))).AddClass("me-2")
 		}
 
 		link.Child(hb.Text(item.Title))
 		dropdownMenu.Child(link)
 	}
 
+	// Stop impersonating, at the end of the menu
+	if stop := templatesshared.ImpersonationStopForm(dashboard, "dropdown-item"); stop != nil {
+		dropdownMenu.Child(hb.Div().Class("dropdown-divider"))
+		dropdownMenu.Child(stop)
+	}
+
 	return userMenu.Children([]hb.TagInterface{userLink, dropdownMenu})
 }
 
 // navbarLoginButton creates the sign-in button
-func navbarLoginButton() *hb.Tag {
+func navbarLoginButton(dashboard types.DashboardInterface) *hb.Tag {
 	return hb.A().
 		Class("btn btn-primary").
-		Href("/login").
-		Child(hb.Text("Sign in"))
+		Href(lo.Ternary(dashboard.GetLoginURL() == "", "/login", dashboard.GetLoginURL())).
+		Child(hb.Text(dashboard.Translate(shared.MESSAGE_SIGN_IN)))
 }
 
 // themetoggle generates the theme toggle button
@@ -302,92 +406,44 @@ func themetoggle(dashboard types.DashboardInterface) *hb.Tag {
 // themedropdown generates the theme dropdown menu
 func themedropdown(dashboard types.DashboardInterface) *hb.Tag {
 	dropdown := hb.NewDiv().Class("nav-item dropdown d-none d-md-flex me-3")
-	link := hb.NewA().Class("nav-link px-0")
+	link := hb.NewA().Class("nav-link px-0").Href("#")
 	link.Attr("data-bs-toggle", "dropdown")
-	link.Attr("tabindex", "-1")
-	link.Attr("aria-label", "Show theme menu")
+	link.Attr("role", "button")
+	link.Attr("aria-expanded", "false")
+	link.Attr("aria-label", dashboard.Translate(shared.MESSAGE_SHOW_THEME_MENU))
 
 	// Theme icon
-	link.Child(hb.Raw(`<svg xmlns="http://www.w3.org/2000/svg" class="icon" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round"><path stroke="none" d="M0 0h24v24H0z" fill="none"/><path d="M12 12m-3 0a3 3 0 1 0 6 0a3 3 0 1 0 -6 0" /><path d="M3 12h1m8 -9v1m8 8h1m-9 8v1m-6.4 -15.4l.7 .7m12.1 -.7l-.7 .7m0 11.4l.7 .7m-12.1 -.7l-.7 .7" /></svg>`))
+	link.Child(hb.Raw(`<svg xmlns="http://www.w3.org/2000/svg" aria-hidden="true" class="icon" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round"><path stroke="none" d="M0 0h24v24H0z" fill="none"/><path d="M12 12m-3 0a3 3 0 1 0 6 0a3 3 0 1 0 -6 0" /><path d="M3 12h1m8 -9v1m8 8h1m-9 8v1m-6.4 -15.4l.7 .7m12.1 -.7l-.7 .7m0 11.4l.7 .7m-12.1 -.7l-.7 .7" /></svg>`))
 
 	// Dropdown menu
 	menu := hb.NewDiv().Class("dropdown-menu dropdown-menu-end")
 
-	// Theme options
-	themes := map[string]string{
-		"light":  "Light",
-		"dark":   "Dark",
-		"system": "System",
-	}
-
-	for value, label := range themes {
-		item := hb.NewButton().Class("dropdown-item")
-		item.Attr("data-bs-theme", value)
-		item.Child(hb.Text(label))
-		menu.Child(item)
-	}
-
-	dropdown.Child(link)
-	dropdown.Child(menu)
-
-	return dropdown
-}
-
-// navbarDropdownUser creates the user dropdown menu
-func navbarDropdownUser(dashboard types.DashboardInterface, user types.User) *hb.Tag {
-	dropdown := hb.NewDiv().Class("dropdown")
-	toggle := hb.NewA().
-		Class("nav-link" + lo.Ternary(dashboard.GetNavbarTextColor() != "", " text-white", "")).
-		Href("#")
-
-	// User avatar with initials
-	avatar := hb.NewDiv().Class("avatar avatar-sm")
-	initials := "U"
-	if len(user.FirstName) > 0 {
-		initials = string(user.FirstName[0])
-	}
-	avatar.Child(hb.NewSpan().Class("avatar-initials").HTML(initials))
-	toggle.Child(avatar)
-
-	// User name
-	userName := user.FirstName
-	if user.LastName != "" {
-		userName += " " + user.LastName
-	}
+	// Theme options, linking to the theme handler
+	handlerUrl := lo.Ternary(dashboard.GetThemeHandlerUrl() == "", "/", dashboard.GetThemeHandlerUrl())
+	themes := dashboard.GetThemesAvailable()
 
-	if userName != "" {
-		toggle.Child(hb.NewSpan().Class("d-none d-md-inline ms-2").HTML(userName))
+	// The theme of the dashboard, or the default theme when it is not one of
+	// the themes of the catalog, e.g. the "default" theme of the constructor
+	currentTheme := dashboard.GetTheme()
+	if !lo.ContainsBy(themes, func(theme types.Theme) bool { return theme.ID == currentTheme }) {
+		currentTheme = dashboard.GetThemeDefault()
 	}
 
-	dropdown.Child(toggle)
+	for _, theme := range themes {
+		themeUrl := lo.Ternary(strings.Contains(handlerUrl, "?"),
+			handlerUrl+"&theme="+url.QueryEscape(theme.ID),
+			handlerUrl+"?theme="+url.QueryEscape(theme.ID))
 
-	// Dropdown menu
-	menu := hb.NewDiv().Class("dropdown-menu dropdown-menu-end")
-
-	// Add user menu items
-	for _, item := range dashboard.GetMenuUserItems() {
-		link := hb.NewA().Class("dropdown-item").Href(item.URL)
-		if item.Icon != "" {
-			link.Child(hb.Raw(item.Icon)).AddClass("me-2")
-		}
-		link.Child(hb.Text(item.Title))
-		menu.Child(link)
-	}
-
-	// Add quick access items if any
-	if quickAccessItems := dashboard.GetMenuQuickAccessItems(); len(quickAccessItems) > 0 {
-		menu.Child(hb.NewDiv().Class("dropdown-divider"))
-		menu.Child(hb.NewDiv().Class("dropdown-header").HTML("Quick Access"))
-		for _, item := range quickAccessItems {
-			link := hb.NewA().Class("dropdown-item").Href(item.URL)
-			if item.Icon != "" {
-				link.Child(hb.Raw(item.Icon)).AddClass("me-2")
-			}
-			link.Child(hb.Text(item.Title))
-			menu.Child(link)
+		item := hb.NewA().Class("dropdown-item").Href(themeUrl)
+		item.Attr("rel", "nofollow")
+		if theme.ID == currentTheme {
+			item.AddClass("active").Attr("aria-current", "true")
 		}
+		item.Child(hb.Text(theme.Name))
+		menu.Child(item)
 	}
 
+	dropdown.Child(link)
 	dropdown.Child(menu)
 
 	return dropdown
@@ -0,0 +1,46 @@
+package tabler_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestThemeDropdownActive(t *testing.T) {
+	tests := []struct {
+		name     string
+		theme    string
+		expected string
+	}{
+		{name: "default", theme: "", expected: "Light"},
+		{name: "dark", theme: "dark", expected: "Dark"},
+		{name: "system", theme: "system", expected: "System"},
+		{name: "brand", theme: "acme", expected: "Acme"},
+	}
+
+	active := regexp.MustCompile(`<a aria-current="true" class="dropdown-item active" href="[^"]*" rel="nofollow">([^<]*)</a>`)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(shared.TEMPLATE_TABLER)
+			d.AddBrandTheme(types.BrandTheme{ID: "acme", Name: "Acme", PrimaryColor: "#0055aa"})
+			d.SetThemeHandlerUrl("/theme")
+			if tt.theme != "" {
+				d.SetTheme(tt.theme)
+			}
+
+			matches := active.FindAllStringSubmatch(d.ToHTML(), -1)
+
+			if len(matches) != 1 {
+				t.Fatalf("expected one active theme, got %d", len(matches))
+			}
+			if matches[0][1] != tt.expected {
+				t.Errorf("expected %q to be active, got %q", tt.expected, matches[0][1])
+			}
+		})
+	}
+}
@@ -1,6 +1,41 @@
 package tabler
 
+import "encoding/json"
+
 // templateScript returns any additional JavaScript for the template
 func templateScript() string {
 	return ``
 }
+
+// sidebarStateScript returns the JavaScript which toggles the sidebar
+// and reports every change to the sidebar state handler, so that
+// the state survives page loads
+func sidebarStateScript(sidebarHandlerUrl string) string {
+	if sidebarHandlerUrl == "" {
+		return ""
+	}
+
+	handlerUrl, _ := json.Marshal(sidebarHandlerUrl)
+
+	return `
+document.addEventListener('DOMContentLoaded', function() {
+	var handlerUrl = ` + string(handlerUrl) + `;
+
+	function reportSidebarState(collapsed) {
+		var url = handlerUrl + (handlerUrl.indexOf('?') === -1 ? '?' : '&') + 'collapsed=' + (collapsed ? '1' : '0');
+		fetch(url, { method: 'POST', credentials: 'same-origin' }).catch(function(e) {
+			console.warn('Error saving sidebar state:', e);
+		});
+	}
+
+	document.querySelectorAll('[data-sidebar-toggle]').forEach(function(toggle) {
+		toggle.addEventListener('click', function(event) {
+			event.preventDefault();
+			var collapsed = document.body.classList.toggle('sidebar-collapse');
+			toggle.setAttribute('aria-expanded', collapsed ? 'false' : 'true');
+			reportSidebarState(collapsed);
+		});
+	});
+});
+`
+}
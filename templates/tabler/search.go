@@ -0,0 +1,48 @@
+package tabler
+
+import (
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// navbarSearch creates the search box of the navbar, or nil when
+// no search handler is set
+func navbarSearch(dashboard types.DashboardInterface) *hb.Tag {
+	if dashboard.GetSearchHandlerUrl() == "" {
+		return nil
+	}
+
+	input := hb.NewInput().
+		Type("search").
+		Name("q").
+		Class("form-control").
+		Placeholder(dashboard.Translate(shared.MESSAGE_SEARCH_PLACEHOLDER)).
+		Attr("autocomplete", "off").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_SEARCH)).
+		Attr("aria-expanded", "false").
+		Attr("aria-controls", "navbar-search-results")
+
+	results := hb.NewDiv().
+		ID("navbar-search-results").
+		Class("dropdown-menu dropdown-menu-card w-100").
+		Style("max-height:400px;overflow-y:auto;").
+		Attr("role", "listbox").
+		Data("search-results", "true")
+
+	form := hb.NewForm().
+		Class("position-relative").
+		Attr("role", "search").
+		Attr("action", dashboard.GetSearchHandlerUrl()).
+		Attr("method", "get").
+		Attr("autocomplete", "off").
+		Data("dashboard-search", "true").
+		Child(hb.NewDiv().Class("input-icon").
+			Child(hb.NewSpan().Class("input-icon-addon").Child(hb.NewI().Class("ti ti-search"))).
+			Child(input)).
+		Child(results)
+
+	return hb.NewDiv().
+		Class("nav-item d-none d-md-flex me-3").
+		Child(form)
+}
@@ -0,0 +1,65 @@
+package tabler
+
+import (
+	"github.com/dracory/dashboard/shared"
+	templatesshared "github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+	"github.com/samber/lo"
+)
+
+// sidebar generates the vertical sidebar with the logo and the main menu,
+// used by the vertical layouts
+func sidebar(dashboard types.DashboardInterface, options layoutOptions) *hb.Tag {
+	aside := hb.Aside().
+		Class("navbar navbar-vertical navbar-expand-lg").
+		ClassIf(options.right, "navbar-right").
+		Attr("data-bs-theme", "dark").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_MENU))
+
+	brand := hb.H1().
+		Class("navbar-brand navbar-brand-autodark").
+		Child(navbarBrandLink(dashboard))
+
+	// User menu for small screens, where the top bar is hidden
+	mobileNav := hb.Div().
+		Class("navbar-nav flex-row d-lg-none").
+		Child(lo.TernaryF(dashboard.GetUser() != nil,
+			func() *hb.Tag { return navbarUserMenu(dashboard, dashboard.GetUser()) },
+			func() *hb.Tag { return navbarLoginButton(dashboard) }))
+
+	menu := hb.Nav().
+		Class("collapse navbar-collapse").
+		ID("sidebar-menu").
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_MAIN_NAVIGATION))
+
+	if region := templatesshared.Region(dashboard, shared.REGION_SIDEBAR_TOP); region != nil {
+		menu.Child(region.Class("px-3 pt-3"))
+	}
+
+	menu.Child(buildNavigation(dashboard.GetMenuMainItems()).AddClass("pt-lg-3"))
+
+	if region := templatesshared.Region(dashboard, shared.REGION_SIDEBAR_BOTTOM); region != nil {
+		menu.Child(region.Class("mt-auto px-3 py-3"))
+	}
+
+	return aside.Child(hb.Div().
+		Class("container-fluid").
+		Child(navbarButtonToggler(dashboard, "#sidebar-menu")).
+		Child(brand).
+		Child(mobileNav).
+		Child(menu))
+}
+
+// sidebarToggle creates the button collapsing the sidebar on large screens,
+// the clicks are reported to the sidebar state handler by sidebarStateScript
+func sidebarToggle(dashboard types.DashboardInterface) *hb.Tag {
+	return hb.A().
+		Href("#").
+		Class("nav-link px-0 me-3 d-none d-lg-flex").
+		Attr("data-sidebar-toggle", "true").
+		Attr("aria-expanded", lo.Ternary(dashboard.GetSidebarCollapsed(), "false", "true")).
+		Attr("aria-label", dashboard.Translate(shared.MESSAGE_TOGGLE_SIDEBAR)).
+		Role("button").
+		Child(hb.I().Class("ti ti-layout-sidebar fs-2").Attr("aria-hidden", "true"))
+}
@@ -0,0 +1,58 @@
+package tabler_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func renderSidebarState(layout string, collapsed bool) string {
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_TABLER)
+	d.SetTablerConfig(types.TablerConfig{Layout: layout})
+	d.SetSidebarHandlerUrl("/sidebar/state")
+	d.SetSidebarCollapsed(collapsed)
+	d.SetUser(types.User{FirstName: "Jane", LastName: "Doe"})
+	d.SetMenuMainItems([]types.MenuItem{{Title: "Home", URL: "/"}})
+	return d.ToHTML()
+}
+
+func TestSidebarStateToggle(t *testing.T) {
+	layouts := []string{
+		shared.TEMPLATE_TABLER_LAYOUT_VERTICAL,
+		shared.TEMPLATE_TABLER_LAYOUT_VERTICAL_RIGHT,
+		shared.TEMPLATE_TABLER_LAYOUT_FLUID_VERTICAL,
+	}
+
+	for _, layout := range layouts {
+		t.Run(layout, func(t *testing.T) {
+			html := renderSidebarState(layout, false)
+
+			expected := []string{
+				`aria-expanded="true" aria-label="Toggle sidebar" class="nav-link px-0 me-3 d-none d-lg-flex" data-sidebar-toggle="true"`,
+				`var handlerUrl = "/sidebar/state";`,
+				`document.querySelectorAll('[data-sidebar-toggle]')`,
+			}
+			for _, s := range expected {
+				if !strings.Contains(html, s) {
+					t.Errorf("expected HTML to contain %q", s)
+				}
+			}
+
+			html = renderSidebarState(layout, true)
+
+			body := regexp.MustCompile(`<body[^>]*>`).FindString(html)
+			if !strings.Contains(body, "sidebar-collapse") {
+				t.Errorf("expected the collapsed state on the body, got %q", body)
+			}
+
+			if !strings.Contains(html, `aria-expanded="false" aria-label="Toggle sidebar"`) {
+				t.Error("expected the toggle to report the collapsed state")
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package tabler
+
+import (
+	dashboardshared "github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/templates/shared"
+	"github.com/dracory/dashboard/types"
+	"github.com/dracory/hb"
+)
+
+// standalonePage adds the auth or error layout to the webpage, the Tabler
+// sign in page: the logo, a centred card with the content and the footer links
+func standalonePage(webpage *hb.HtmlWebpage, dashboard types.DashboardInterface) string {
+	webpage.Body().Class("antialiased d-flex flex-column theme-" + dashboard.GetTheme())
+
+	container := hb.Div().Class("container container-tight py-4")
+
+	if logo := shared.AuthLogo(dashboard); logo != nil {
+		container.Child(hb.Div().Class("text-center mb-4").
+			Child(logo.Class("navbar-brand navbar-brand-autodark")))
+	}
+
+	container.Child(hb.Div().Class("card card-md").
+		Child(hb.Div().Class("card-body").Children(shared.AuthContent(dashboard))))
+
+	if footer := shared.AuthFooter(dashboard, "text-secondary"); footer != nil {
+		container.Child(footer)
+	}
+
+	webpage.Body().Child(shared.SkipLink(dashboard, "visually-hidden-focusable"))
+
+	// Add the environment ribbon
+	if ribbon := shared.EnvironmentRibbon(dashboard); ribbon != nil {
+		webpage.Body().Child(ribbon)
+	}
+
+	webpage.Body().Child(shared.MainContent(container).Class("page page-center"))
+
+	// Add the body end region
+	if region := shared.Region(dashboard, dashboardshared.REGION_BODY_END); region != nil {
+		webpage.Body().Child(region)
+	}
+
+	return webpage.ToHTML()
+}
@@ -2,5 +2,16 @@ package tabler
 
 // templateStyle returns any additional CSS styles needed for the template
 func templateStyle() string {
-	return ``
+	return `
+@media (min-width: 992px) {
+	body.sidebar-collapse .navbar-vertical.navbar-expand-lg {
+		display: none;
+	}
+	body.sidebar-collapse .navbar-vertical.navbar-expand-lg ~ .navbar,
+	body.sidebar-collapse .navbar-vertical.navbar-expand-lg ~ .page-wrapper {
+		margin-left: 0;
+		margin-right: 0;
+	}
+}
+`
 }
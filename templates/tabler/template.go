@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	dashboardshared "github.com/dracory/dashboard/shared"
 	"github.com/dracory/dashboard/templates/shared"
 	"github.com/dracory/dashboard/types"
 	"github.com/dracory/hb"
@@ -37,12 +38,42 @@ func (t *Template) getStylesAndScripts(dashboard types.DashboardInterface) (
 		scripts = append(scripts, script)
 	}
 
-	styleURLs = append(styleURLs, "https://cdn.jsdelivr.net/npm/@tabler/core@1.0.0/dist/css/tabler.min.css")
+	// Add sidebar state JavaScript
+	if script := sidebarStateScript(dashboard.GetSidebarHandlerUrl()); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add search JavaScript
+	if script := shared.SearchScript(dashboard.GetSearchHandlerUrl(), dashboard.Translate(dashboardshared.MESSAGE_SEARCH_NO_RESULTS)); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add the favicon tint of the environment
+	if script := shared.EnvironmentFaviconScript(dashboard); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add the session timeout JavaScript
+	if script := shared.SessionTimeoutScript(dashboard); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	// Add command palette JavaScript
+	if script := shared.CommandPaletteScript(dashboard); script != "" {
+		scripts = append(scripts, script)
+	}
+
+	styleURLs = append(styleURLs, lo.Ternary(dashboard.IsRTL(), stylesheetURLRTL, stylesheetURL))
 	styleURLs = append(styleURLs, "https://cdn.jsdelivr.net/npm/@tabler/icons-webfont@2.47.0/tabler-icons.min.css")
 	scriptURLs = append(scriptURLs, "https://cdn.jsdelivr.net/npm/@tabler/core@1.0.0/dist/js/tabler.min.js")
 
 	styles = append(styles, "@import url('https://fonts.googleapis.com/css2?family=Inter:wght@300;400;500;600;700&display=swap');")
 
+	// Add brand theme CSS custom properties, before the custom styles
+	if brand, ok := shared.BrandThemeActive(dashboard); ok {
+		styles = append(styles, brandThemeStyle(brand))
+	}
+
 	styleURLs = append(styleURLs, dashboard.GetStyleURLs()...)
 	scriptURLs = append(scriptURLs, dashboard.GetScriptURLs()...)
 
@@ -64,7 +95,7 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 	webpage := hb.Webpage()
 
 	// Set the page title
-	webpage.SetTitle(dashboard.GetTitle())
+	webpage.SetTitle(shared.EnvironmentTitle(dashboard))
 
 	// Add favicon
 	webpage.SetFavicon(favicon)
@@ -102,8 +133,14 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 	}
 
 	// Set HTML attributes
-	webpage.Attr("lang", "en")
-	webpage.Attr("data-bs-theme", lo.Ternary(dashboard.GetTheme() == "dark", "dark", "light"))
+	webpage.SetLanguage(dashboard.GetLocale())
+	webpage.Attr("dir", dashboard.GetDirection())
+	webpage.Attr("data-bs-theme", lo.Ternary(dashboard.IsThemeDark(), "dark", "light"))
+
+	// The auth and error page layouts replace the navigation of the dashboard
+	if shared.IsStandaloneLayout(dashboard) {
+		return standalonePage(webpage, dashboard)
+	}
 
 	// Set body classes
 	bodyClasses := []string{
@@ -111,6 +148,15 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 		"theme-" + dashboard.GetTheme(),
 	}
 
+	// Add layout classes
+	options := layoutOptionsFromName(dashboard.GetLayout())
+	if options.boxed {
+		bodyClasses = append(bodyClasses, "layout-boxed")
+	}
+	if options.fluid {
+		bodyClasses = append(bodyClasses, "layout-fluid")
+	}
+
 	// Add sidebar collapse class if sidebar is collapsed
 	if dashboard.GetSidebarCollapsed() {
 		bodyClasses = append(bodyClasses, "sidebar-collapse")
@@ -121,16 +167,44 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 	// Generate the layout
 	layoutHTML := t.layout(dashboard)
 
+	// Add the skip link, the first stop of keyboard users
+	webpage.Body().Child(shared.SkipLink(dashboard, "visually-hidden-focusable"))
+
+	// Add the environment ribbon, in the corner of the page
+	if ribbon := shared.EnvironmentRibbon(dashboard); ribbon != nil {
+		webpage.Body().Child(ribbon)
+	}
+
+	// Add the impersonation banner, above the navbar
+	if banner := shared.ImpersonationBanner(dashboard); banner != nil {
+		webpage.Body().Child(banner)
+	}
+
 	// Add the layout to the webpage
 	webpage.Body().Child(hb.Raw(layoutHTML))
 
+	// Add the command palette if enabled
+	if modal := commandPaletteModal(dashboard); modal != nil {
+		webpage.Body().Child(modal)
+	}
+
+	// Add the session timeout modal if the session expiry is set
+	if modal := shared.SessionTimeoutModal(dashboard, "bs-"); modal != nil {
+		webpage.Body().Child(modal)
+	}
+
 	// Add back to top button
 	webpage.Body().Child(hb.NewDiv().Class("back-to-top").Child(
-		hb.NewA().Href("#").Class("btn btn-primary btn-icon").Child(
-			hb.NewI().Class("ti ti-arrow-up"),
-		),
+		hb.NewA().Href("#").Class("btn btn-primary btn-icon").
+			Attr("aria-label", dashboard.Translate(dashboardshared.MESSAGE_BACK_TO_TOP)).
+			Child(hb.NewI().Class("ti ti-arrow-up").Attr("aria-hidden", "true")),
 	))
 
+	// Add the body end region, after the layout and the modals
+	if region := shared.Region(dashboard, dashboardshared.REGION_BODY_END); region != nil {
+		webpage.Body().Child(region)
+	}
+
 	// Handle redirect if needed
 	if redirectURL := dashboard.GetRedirectUrl(); redirectURL != "" {
 		redirectTime := "0"
@@ -148,26 +222,37 @@ func (t *Template) ToHTML(dashboard types.DashboardInterface) string {
 
 // layout generates the main layout structure
 func (t *Template) layout(dashboard types.DashboardInterface) string {
-	content := dashboard.GetContent()
+	options := layoutOptionsFromName(dashboard.GetLayout())
 
 	// Create page container
 	container := hb.NewDiv().Class("page")
 
+	// Add sidebar for the vertical layouts
+	if options.vertical {
+		container.Child(sidebar(dashboard, options))
+	}
+
 	// Add navbar
-	container.Child(hb.Raw(topNavigation(dashboard)))
+	container.Child(hb.Raw(topNavigation(dashboard, options)))
 
 	// Create page wrapper
 	contentWrapper := hb.NewDiv().Class("page-wrapper")
 
-	// Add page header if title exists
-	if title := dashboard.GetTitle(); title != "" {
+	// Add page header if title exists, or if the page header end region is set
+	pageHeaderEnd := shared.Region(dashboard, dashboardshared.REGION_PAGE_HEADER_END)
+	if title := dashboard.GetTitle(); title != "" || pageHeaderEnd != nil {
 		header := hb.NewDiv().Class("page-header d-print-none")
-		headerContent := hb.NewDiv().Class("container-xl")
+		headerContent := hb.NewDiv().Class(options.containerClass())
+
+		// The page header sits on the dark navbar in the overlap layout
+		if options.overlap {
+			header.AddClass("text-white").Attr("data-bs-theme", "dark")
+		}
 		headerRow := hb.NewDiv().Class("row g-2 align-items-center")
 
 		// Left side (title and breadcrumb)
 		headerCol := hb.NewDiv().Class("col")
-		headerCol.Child(hb.NewH1().Class("page-title").Text(title))
+		headerCol.ChildIf(title != "", hb.NewH1().Class("page-title").Text(title))
 
 		// Add breadcrumb if available
 		if breadcrumb := dashboard.GetBreadcrumb(); len(breadcrumb) > 0 {
@@ -177,12 +262,14 @@ func (t *Template) layout(dashboard types.DashboardInterface) string {
 			}
 
 			// Create breadcrumb navigation
-			navEl := hb.NewNav().Class("breadcrumb")
+			navEl := hb.NewNav().
+				Class("breadcrumb").
+				Attr("aria-label", dashboard.Translate(dashboardshared.MESSAGE_BREADCRUMB))
 			for i, item := range breadcrumb {
 				itemEl := hb.NewSpan().Class("breadcrumb-item")
 				if i == len(breadcrumb)-1 {
 					// Last item is active (not clickable)
-					itemEl.Class("active").AddChild(hb.NewSpan().Text(item.Title))
+					itemEl.Class("active").Attr("aria-current", "page").AddChild(hb.NewSpan().Text(item.Title))
 				} else if item.URL != "" {
 					// Clickable breadcrumb item
 					itemEl.AddChild(hb.NewA().Href(item.URL).Text(item.Title))
@@ -219,14 +306,19 @@ func (t *Template) layout(dashboard types.DashboardInterface) string {
 
 		actionsCol.Child(actionsRow)
 		headerRow.Child(actionsCol)
+
+		// Page header end region, after the actions
+		if pageHeaderEnd != nil {
+			headerRow.Child(hb.NewDiv().Class("col-auto d-print-none").Child(pageHeaderEnd.Class("d-flex align-items-center gap-2")))
+		}
 		headerContent.Child(headerRow)
 		header.Child(headerContent)
 		contentWrapper.Child(header)
 	}
 
 	// Add page body
-	pageBody := hb.NewDiv().Class("page-body")
-	pageBodyContent := hb.NewDiv().Class("container-xl")
+	pageBody := shared.MainContent().Class("page-body")
+	pageBodyContent := hb.NewDiv().Class(options.containerClass())
 
 	// Add alerts if any
 	alerts := dashboard.GetAlerts()
@@ -243,22 +335,38 @@ func (t *Template) layout(dashboard types.DashboardInterface) string {
 			Type("button").
 			Class("btn-close").
 			Attr("data-bs-dismiss", "alert").
-			Attr("aria-label", "Close")
+			Attr("aria-label", dashboard.Translate(dashboardshared.MESSAGE_CLOSE))
 
 		alertEl.AddChild(closeBtn)
 		pageBodyContent.AddChild(alertEl)
 	}
 
-	// Add main content
-	pageBodyContent.Child(hb.Raw(content))
+	// Add the widgets and the main content, between the content before and
+	// after regions
+	if region := shared.Region(dashboard, dashboardshared.REGION_CONTENT_BEFORE); region != nil {
+		pageBodyContent.Child(region)
+	}
+	if widgets := shared.Widgets(dashboard); widgets != nil {
+		pageBodyContent.Child(widgets)
+	}
+	pageBodyContent.Child(shared.FragmentPlaceholder(dashboard, shared.FRAGMENT_CONTENT))
+	if region := shared.Region(dashboard, dashboardshared.REGION_CONTENT_AFTER); region != nil {
+		pageBodyContent.Child(region)
+	}
 	pageBody.Child(pageBodyContent)
 
 	contentWrapper.Child(pageBody)
+
+	// Add footer if set
+	if footer := footer(dashboard, options); footer != nil {
+		contentWrapper.Child(footer)
+	}
+
 	container.Child(contentWrapper)
 
 	// Add modals if any
 	modals := dashboard.GetModals()
-	for _, modal := range modals {
+	for index, modal := range modals {
 		modalEl := hb.NewDiv()
 		modalEl.Class("modal fade")
 		modalEl.Attr("id", modal.ID)
@@ -286,18 +394,18 @@ func (t *Template) layout(dashboard types.DashboardInterface) string {
 			closeBtn.Type("button")
 			closeBtn.Class("btn-close")
 			closeBtn.Attr("data-bs-dismiss", "modal")
-			closeBtn.Attr("aria-label", "Close")
+			closeBtn.Attr("aria-label", dashboard.Translate(dashboardshared.MESSAGE_CLOSE))
 			headerEl.Child(closeBtn)
 		}
 		contentEl.Child(headerEl)
 
 		// Body
-		bodyEl := hb.NewDiv().Class("modal-body").HTML(modal.Content)
+		bodyEl := hb.NewDiv().Class("modal-body").Child(shared.FragmentPlaceholder(dashboard, shared.FragmentName(shared.FRAGMENT_MODAL_BODY, index)))
 		contentEl.Child(bodyEl)
 
 		// Footer
-		if modal.Footer != "" {
-			footerEl := hb.NewDiv().Class("modal-footer").HTML(modal.Footer)
+		if modal.Footer != "" || modal.FooterFragment != nil {
+			footerEl := hb.NewDiv().Class("modal-footer").Child(shared.FragmentPlaceholder(dashboard, shared.FragmentName(shared.FRAGMENT_MODAL_FOOTER, index)))
 			contentEl.Child(footerEl)
 		} else if modal.CloseButton {
 			// Add default close button if no footer is provided
@@ -306,7 +414,7 @@ func (t *Template) layout(dashboard types.DashboardInterface) string {
 				Type("button").
 				Class("btn btn-link link-secondary").
 				Attr("data-bs-dismiss", "modal").
-				Text("Close")
+				Text(dashboard.Translate(dashboardshared.MESSAGE_CLOSE))
 			footerEl.Child(closeBtn)
 			contentEl.Child(footerEl)
 		}
@@ -0,0 +1,55 @@
+package tabler
+
+import "github.com/dracory/dashboard/types"
+
+// Ensure Template implements the ThemeCatalogInterface
+var _ types.ThemeCatalogInterface = (*Template)(nil)
+
+// Theme constants
+const (
+	// THEME_LIGHT is the light color mode
+	THEME_LIGHT = "light"
+	// THEME_DARK is the dark color mode
+	THEME_DARK = "dark"
+	// THEME_SYSTEM follows the color mode of the operating system
+	THEME_SYSTEM = "system"
+)
+
+// stylesheetURL is the Tabler stylesheet, shared by all color modes
+const stylesheetURL = "https://cdn.jsdelivr.net/npm/@tabler/core@1.0.0/dist/css/tabler.min.css"
+
+// stylesheetURLRTL is the right-to-left build of the Tabler stylesheet
+const stylesheetURLRTL = "https://cdn.jsdelivr.net/npm/@tabler/core@1.0.0/dist/css/tabler.rtl.min.css"
+
+// Themes returns the color modes supported by Tabler
+func (t *Template) Themes() []types.Theme {
+	return []types.Theme{
+		{
+			ID:               THEME_LIGHT,
+			Name:             "Light",
+			PreviewColors:    []string{"#206bc4", "#ffffff"},
+			StylesheetURL:    stylesheetURL,
+			StylesheetURLRTL: stylesheetURLRTL,
+		},
+		{
+			ID:               THEME_DARK,
+			Name:             "Dark",
+			IsDark:           true,
+			PreviewColors:    []string{"#206bc4", "#1a2234"},
+			StylesheetURL:    stylesheetURL,
+			StylesheetURLRTL: stylesheetURLRTL,
+		},
+		{
+			ID:               THEME_SYSTEM,
+			Name:             "System",
+			PreviewColors:    []string{"#206bc4", "#ffffff", "#1a2234"},
+			StylesheetURL:    stylesheetURL,
+			StylesheetURLRTL: stylesheetURLRTL,
+		},
+	}
+}
+
+// ThemeDefault returns the ID of the default Tabler theme
+func (t *Template) ThemeDefault() string {
+	return THEME_LIGHT
+}
@@ -0,0 +1,158 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/templates/adminlte"
+	"github.com/dracory/dashboard/templates/bootstrap"
+	"github.com/dracory/dashboard/templates/tabler"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestThemeCatalogs(t *testing.T) {
+	tests := []struct {
+		name    string
+		catalog types.ThemeCatalogInterface
+		count   int
+	}{
+		{name: "bootstrap", catalog: &bootstrap.Template{}, count: 26},
+		{name: "tabler", catalog: &tabler.Template{}, count: 3},
+		{name: "adminlte", catalog: &adminlte.Template{}, count: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			themes := tt.catalog.Themes()
+			if len(themes) != tt.count {
+				t.Fatalf("expected %d themes, got %d", tt.count, len(themes))
+			}
+
+			seen := map[string]bool{}
+			for _, theme := range themes {
+				if theme.ID == "" || theme.Name == "" {
+					t.Errorf("theme %+v is missing an ID or name", theme)
+				}
+				if theme.StylesheetURL == "" {
+					t.Errorf("theme %q has no stylesheet", theme.ID)
+				}
+				if len(theme.PreviewColors) == 0 {
+					t.Errorf("theme %q has no preview colors", theme.ID)
+				}
+				if seen[theme.ID] {
+					t.Errorf("duplicate theme %q", theme.ID)
+				}
+				seen[theme.ID] = true
+			}
+
+			if !seen[tt.catalog.ThemeDefault()] {
+				t.Errorf("default theme %q not found in catalog", tt.catalog.ThemeDefault())
+			}
+		})
+	}
+}
+
+func TestThemeCatalogDarkFlag(t *testing.T) {
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+
+	d.SetTheme(bootstrap.THEME_DARKLY)
+	if !d.IsThemeDark() {
+		t.Error("expected darkly to be a dark theme")
+	}
+
+	d.SetTheme(bootstrap.THEME_FLATLY)
+	if d.IsThemeDark() {
+		t.Error("expected flatly to be a light theme")
+	}
+}
+
+func TestThemesRestrictValidation(t *testing.T) {
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+	d.SetThemesRestrict(map[string]string{
+		bootstrap.THEME_DEFAULT: "Company Light",
+		bootstrap.THEME_DARKLY:  "Company Dark",
+		"unknown":               "Unknown",
+	})
+
+	restrict := d.GetThemesRestrict()
+	if len(restrict) != 2 {
+		t.Fatalf("expected 2 restricted themes, got %d", len(restrict))
+	}
+	if _, ok := restrict["unknown"]; ok {
+		t.Error("expected unknown theme to be removed")
+	}
+	if restrict[bootstrap.THEME_DARKLY] != "Company Dark" {
+		t.Errorf("expected custom label to be kept, got %q", restrict[bootstrap.THEME_DARKLY])
+	}
+}
+
+func TestThemesRestrictAvailableAndDefault(t *testing.T) {
+	d := dashboard.New()
+	d.SetTemplate(shared.TEMPLATE_BOOTSTRAP)
+	d.SetUser(types.User{FirstName: "Jane"})
+	d.SetThemesRestrict(map[string]string{
+		bootstrap.THEME_DARKLY: "Company Dark",
+		bootstrap.THEME_FLATLY: "",
+	})
+
+	available := d.GetThemesAvailable()
+	if len(available) != 2 {
+		t.Fatalf("expected 2 available themes, got %d", len(available))
+	}
+	if available[0].ID != bootstrap.THEME_FLATLY || available[0].Name != "Flatly" {
+		t.Errorf("expected flatly with catalog name first, got %+v", available[0])
+	}
+	if available[1].ID != bootstrap.THEME_DARKLY || available[1].Name != "Company Dark" {
+		t.Errorf("expected darkly with custom label second, got %+v", available[1])
+	}
+
+	// Disallowed theme falls back to the first available theme
+	d.SetTheme(bootstrap.THEME_VAPOR)
+	if d.GetTheme() != bootstrap.THEME_FLATLY {
+		t.Errorf("expected disallowed theme to be coerced to flatly, got %q", d.GetTheme())
+	}
+
+	// Disallowed theme falls back to the configured default theme
+	d.SetThemeDefault(bootstrap.THEME_DARKLY)
+	if d.GetTheme() != bootstrap.THEME_DARKLY {
+		t.Errorf("expected disallowed theme to be coerced to darkly, got %q", d.GetTheme())
+	}
+
+	html := d.ToHTML()
+	if !strings.Contains(html, "bootswatch@5.3.2/dist/darkly/") {
+		t.Error("expected the default theme stylesheet to be rendered")
+	}
+	if strings.Contains(html, "?theme="+bootstrap.THEME_VAPOR) {
+		t.Error("expected the theme switcher to only list the restricted themes")
+	}
+	if !strings.Contains(html, "Company Dark") {
+		t.Error("expected the theme switcher to use the custom label")
+	}
+}
+
+func TestThemeSwitcherEscapesThemeID(t *testing.T) {
+	templates := []string{shared.TEMPLATE_BOOTSTRAP, shared.TEMPLATE_TABLER, shared.TEMPLATE_ADMINLTE}
+
+	for _, template := range templates {
+		t.Run(template, func(t *testing.T) {
+			d := dashboard.New()
+			d.SetTemplate(template)
+			d.SetUser(types.User{FirstName: "Jane"})
+			d.SetThemeHandlerUrl("/theme")
+			d.AddBrandTheme(types.BrandTheme{ID: "acme&co dark", Name: "Acme", PrimaryColor: "#0055aa"})
+
+			html := d.ToHTML()
+
+			if !strings.Contains(html, "/theme?theme=acme%26co+dark") {
+				t.Error("expected the theme ID to be escaped in the theme switcher URL")
+			}
+			if strings.Contains(html, "?theme=acme&amp;co") || strings.Contains(html, "?theme=acme&co") {
+				t.Error("expected the theme ID not to add query parameters")
+			}
+		})
+	}
+}
@@ -12,6 +12,26 @@ import (
 // ThemeHandler checks for the supplied theme and sets the theme name in the session
 func ThemeHandler(w http.ResponseWriter, r *http.Request) {
 	themeName := req.GetStringTrimmed(r, "theme")
+	themeSave(w, r, themeName)
+}
+
+// ThemeHandlerWithRestrict returns a theme handler which only accepts the themes
+// found in the themes restrict map (as passed to SetThemesRestrict), any other
+// theme is replaced by the supplied default theme
+func ThemeHandlerWithRestrict(themesRestrict map[string]string, themeDefault string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		themeName := req.GetStringTrimmed(r, "theme")
+
+		if _, allowed := themesRestrict[themeName]; themeName != "" && len(themesRestrict) > 0 && !allowed {
+			themeName = themeDefault
+		}
+
+		themeSave(w, r, themeName)
+	}
+}
+
+// themeSave sets the theme cookie and redirects back to the page
+func themeSave(w http.ResponseWriter, r *http.Request, themeName string) {
 	redirect := req.GetStringTrimmedOr(r, "redirect", "/")
 
 	// Only set cookie if themeName is not empty
@@ -125,3 +125,55 @@ func TestThemeHandler(t *testing.T) {
 
 // now is a helper function to get the current time for cookie expiration checks
 var now = time.Now()
+
+func TestThemeHandlerWithRestrict(t *testing.T) {
+	handler := dashboard.ThemeHandlerWithRestrict(map[string]string{
+		"flatly": "Light",
+		"darkly": "Dark",
+	}, "flatly")
+
+	tests := []struct {
+		name          string
+		theme         string
+		expectedTheme string
+	}{
+		{name: "allowed theme", theme: "darkly", expectedTheme: "darkly"},
+		{name: "disallowed theme", theme: "vapor", expectedTheme: "flatly"},
+		{name: "empty theme", theme: "", expectedTheme: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/theme?theme="+tt.theme, nil)
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != http.StatusFound {
+				t.Errorf("handler returned wrong status code: got %v want %v", rr.Code, http.StatusFound)
+			}
+
+			var themeCookie *http.Cookie
+			for _, cookie := range rr.Result().Cookies() {
+				if cookie.Name == shared.THEME_COOKIE_KEY {
+					themeCookie = cookie
+					break
+				}
+			}
+
+			if tt.expectedTheme == "" {
+				if themeCookie != nil {
+					t.Error("Expected no theme cookie to be set")
+				}
+				return
+			}
+
+			if themeCookie == nil {
+				t.Fatal("Expected theme cookie to be set")
+			}
+			if themeCookie.Value != tt.expectedTheme {
+				t.Errorf("Unexpected theme cookie value: got %v want %v", themeCookie.Value, tt.expectedTheme)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package types
+
+// AuthForm configures the built-in authentication forms
+type AuthForm struct {
+	Action            string // URL the form is posted to, defaults to the login or register URL, or the current URL for the other forms
+	CSRFFieldName     string // Name of the hidden CSRF field (default: "csrf_token")
+	CSRFToken         string // Value of the hidden CSRF field, the field is omitted when empty
+	Email             string // Value the email field is filled in with
+	ForgotPasswordURL string // URL of the forgot password page, the link is omitted when empty
+	RememberMe        bool   // Whether the sign in form has a "remember me" checkbox
+}
@@ -0,0 +1,17 @@
+package types
+
+// BrandTheme represents a custom theme built from design tokens.
+// Brand themes are added to the theme catalog of the active template and are
+// converted to the CSS custom properties of the template when selected
+type BrandTheme struct {
+	ID             string  // Theme identifier, as used with SetTheme
+	Name           string  // Display name in the theme switchers
+	IsDark         bool    // Whether the theme uses the dark color mode
+	PrimaryColor   string  // Primary color (hex, e.g. "#0055aa")
+	SecondaryColor string  // Secondary color (hex)
+	SuccessColor   string  // Success color (hex)
+	DangerColor    string  // Danger color (hex)
+	FontFamily     string  // Font family (e.g. "Inter, sans-serif")
+	BorderRadius   string  // Border radius (e.g. "0.5rem")
+	SpacingScale   float64 // Multiplier for the default spacing (0 keeps the default)
+}
@@ -0,0 +1,12 @@
+package types
+
+// Command represents an entry of the command palette
+type Command struct {
+	Title    string   `json:"title"`              // Display text of the command
+	URL      string   `json:"url,omitempty"`      // URL opened by the command
+	Target   string   `json:"target,omitempty"`   // Target of the URL, e.g. "_blank" (optional)
+	OnClick  string   `json:"onclick,omitempty"`  // JavaScript executed instead of opening the URL (optional)
+	Icon     HTML     `json:"icon,omitempty"`     // Icon CSS classes or icon HTML (optional)
+	Category string   `json:"category,omitempty"` // Category shown next to the command, e.g. "Users"
+	Keywords []string `json:"keywords,omitempty"` // Extra words matched when searching (optional)
+}
@@ -17,9 +17,18 @@ type BootstrapConfig struct {
 
 // AdminLTEConfig represents the specific configuration for the adminlte template
 type AdminLTEConfig struct {
+	SidebarSkin   string // one of the shared.TEMPLATE_ADMINLTE_SIDEBAR_SKIN_* constants (default: dark)
+	SidebarAccent string // accent color of the sidebar, e.g. primary, info, success, warning, danger, indigo (default: primary)
+	NavbarVariant string // white, light, dark or an accent color, e.g. primary (default: white)
+	FixedHeader   bool   // keeps the navbar at the top while scrolling
+	FixedSidebar  bool   // keeps the sidebar in place while scrolling
+	FixedFooter   bool   // keeps the footer at the bottom of the viewport
+	LayoutBoxed   bool   // centers the page in a boxed container
+	LayoutTopNav  bool   // replaces the sidebar with a menu in the navbar
+	TextSize      string // one of the shared.TEMPLATE_ADMINLTE_TEXT_SIZE_* constants (default: normal)
 }
 
 // TablerConfig represents the specific configuration for the tabler template
 type TablerConfig struct {
-	Layout string
+	Layout string // one of the shared.TEMPLATE_TABLER_LAYOUT_* constants (default: horizontal)
 }
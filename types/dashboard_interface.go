@@ -1,10 +1,21 @@
 package types
 
+import "io"
+
 type DashboardInterface interface {
 	// GetContent returns the content of the webpage
-	GetContent() string
+	GetContent() HTML
 	// SetContent sets the content of the webpage
-	SetContent(content string)
+	SetContent(content HTML)
+
+	// GetContentFragment returns the content fragment of the webpage
+	GetContentFragment() Fragment
+	// SetContentFragment sets the content of the webpage as a fragment,
+	// written while rendering, it takes precedence over SetContent
+	SetContentFragment(content Fragment)
+	// GetFragmentToken returns the random token of the fragment
+	// placeholders, generated by Render for each render
+	GetFragmentToken() string
 
 	// GetSubtitle returns the subtitle of the webpage
 	GetSubtitle() string
@@ -22,15 +33,35 @@ type DashboardInterface interface {
 	SetLogoImageURL(logoImageURL string)
 
 	// GetLogoRawHtml returns the logo raw HTML of the dashboard
-	GetLogoRawHtml() string
+	GetLogoRawHtml() HTML
 	// SetLogoRawHtml sets the logo raw HTML of the dashboard
-	SetLogoRawHtml(logoRawHtml string)
+	SetLogoRawHtml(logoRawHtml HTML)
 
 	// GetLogoRedirectURL returns the logo redirect URL of the dashboard
 	GetLogoRedirectURL() string
 	// SetLogoRedirectURL sets the logo redirect URL of the dashboard
 	SetLogoRedirectURL(logoRedirectURL string)
 
+	// GetLayout returns the layout of the template (if supported by the template)
+	GetLayout() string
+	// SetLayout sets the layout of the template (if supported by the template)
+	SetLayout(layout string)
+
+	// GetPageLayout returns the page layout, dashboard (default), auth or error
+	GetPageLayout() string
+	// SetPageLayout sets the page layout, see the shared.PAGE_LAYOUT_* constants
+	SetPageLayout(pageLayout string)
+
+	// GetTablerConfig returns the configuration specific to the tabler template
+	GetTablerConfig() TablerConfig
+	// SetTablerConfig sets the configuration specific to the tabler template
+	SetTablerConfig(config TablerConfig)
+
+	// GetAdminLTEConfig returns the configuration specific to the adminlte template
+	GetAdminLTEConfig() AdminLTEConfig
+	// SetAdminLTEConfig sets the configuration specific to the adminlte template
+	SetAdminLTEConfig(config AdminLTEConfig)
+
 	// GetMenuMainItems returns the menu items for the main menu
 	GetMenuMainItems() []MenuItem
 	// SetMenuMainItems sets the menu items for the main menu
@@ -61,6 +92,19 @@ type DashboardInterface interface {
 	// SetUser sets the user of the dashboard
 	SetUser(user User)
 
+	// Impersonation, the real user acting as the user set with SetUser
+	GetImpersonation() *Impersonation
+	SetImpersonation(impersonation Impersonation)
+	ClearImpersonation()
+
+	// Environment indicator, see the shared.ENVIRONMENT_* constants
+	GetEnvironment() *Environment
+	SetEnvironment(environment Environment)
+
+	// Session timeout countdown
+	GetSessionTimeout() *SessionTimeout
+	SetSessionTimeout(sessionTimeout SessionTimeout)
+
 	// GetRedirectTime returns the redirect time of the dashboard
 	GetRedirectTime() string
 	// SetRedirectTime sets the redirect time of the dashboard
@@ -91,6 +135,15 @@ type DashboardInterface interface {
 	// SetStyleURLs sets the style URLs of the dashboard
 	SetStyleURLs(styleURLs []string)
 
+	// Navbar extras, rendered at the end of the navbar (the navbar-end region)
+	GetNavbarFragments() []Fragment
+	AddNavbarFragment(fragment Fragment)
+
+	// Layout regions, see the shared.REGION_* constants
+	GetRegionFragments(region string) []Fragment
+	AddRegionFragments(region string, fragments ...Fragment)
+	ClearRegion(region string)
+
 	// Navbar theming methods
 	GetNavbarBackgroundColorMode() string
 	SetNavbarBackgroundColorMode(mode string)
@@ -111,8 +164,14 @@ type DashboardInterface interface {
 	SetTheme(theme string)
 	GetThemeHandlerUrl() string
 	SetThemeHandlerUrl(url string)
+	GetThemes() []Theme
+	GetBrandThemes() []BrandTheme
+	AddBrandTheme(theme BrandTheme)
 	GetThemesRestrict() map[string]string
 	SetThemesRestrict(themes map[string]string)
+	GetThemesAvailable() []Theme
+	GetThemeDefault() string
+	SetThemeDefault(theme string)
 
 	// UI Configuration
 	GetMenuShowText() bool
@@ -128,6 +187,12 @@ type DashboardInterface interface {
 	// Sidebar state
 	GetSidebarCollapsed() bool
 	SetSidebarCollapsed(collapsed bool)
+	GetSidebarHandlerUrl() string
+	SetSidebarHandlerUrl(url string)
+
+	// Search
+	GetSearchHandlerUrl() string
+	SetSearchHandlerUrl(url string)
 
 	// Breadcrumb
 	GetBreadcrumb() []BreadcrumbItem
@@ -147,5 +212,33 @@ type DashboardInterface interface {
 	AddModal(modal Modal)
 	ClearModals()
 
+	// Widgets
+	GetWidgets() []Widget
+	AddWidget(widget Widget)
+	ClearWidgets()
+
+	// Localization
+	GetLocale() string
+	SetLocale(locale string)
+	GetDirection() string
+	SetDirection(direction string)
+	IsRTL() bool
+	GetTranslator() TranslatorInterface
+	SetTranslator(translator TranslatorInterface)
+	Translate(key string) string
+
+	// Command palette
+	GetCommandPaletteEnabled() bool
+	SetCommandPaletteEnabled(enabled bool)
+	GetCommands() []Command
+	AddCommand(command Command)
+	ClearCommands()
+
+	// Footer
+	GetFooter() *Footer
+	SetFooter(footer Footer)
+
+	// Render writes the HTML of the dashboard to w, streaming the fragments
+	Render(w io.Writer) error
 	ToHTML() string
 }
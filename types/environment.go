@@ -0,0 +1,9 @@
+package types
+
+// Environment represents the environment the dashboard runs in, e.g.
+// "staging", shown on every page so production is not mistaken for it
+type Environment struct {
+	Name    string // Name of the environment, nothing is shown when empty
+	Color   string // CSS color of the indicator (default: by name, red for production)
+	Display string // How the environment is shown, "ribbon" (default) or "badge"
+}
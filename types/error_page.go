@@ -0,0 +1,9 @@
+package types
+
+// ErrorPage describes the error page rendered for an HTTP status code
+type ErrorPage struct {
+	Status    int    // HTTP status code, e.g. http.StatusNotFound
+	Title     string // Title, defaults to the translated title of the status
+	Message   string // Message, defaults to the translated message of the status
+	RequestID string // ID of the request, shown for reference when set
+}
@@ -0,0 +1,27 @@
+package types
+
+// Footer represents the page footer
+type Footer struct {
+	Copyright   string         // Copyright text, e.g. "© 2025 Acme Inc."
+	Columns     []FooterColumn // Columns of links
+	Version     string         // Version or build string
+	Environment string         // Environment label, e.g. "staging"
+	HTML        HTML           // Custom HTML, rendered as is below the other parts
+	Fragment    Fragment       // Custom content written while rendering, takes precedence over HTML
+}
+
+// FooterColumn represents a titled column of links in the footer
+type FooterColumn struct {
+	Title string     // Column heading (optional)
+	Links []MenuItem // Links in the column
+}
+
+// IsEmpty returns whether the footer has nothing to render
+func (f Footer) IsEmpty() bool {
+	return f.Copyright == "" &&
+		len(f.Columns) == 0 &&
+		f.Version == "" &&
+		f.Environment == "" &&
+		f.HTML == "" &&
+		f.Fragment == nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"context"
+	"io"
+
+	"github.com/dracory/hb"
+)
+
+// Fragment is a part of the page written straight into the output when the
+// page is rendered, without being serialised to a string first. It is used
+// for the content, the modal bodies and footers, the footer, the layout
+// regions and the widgets.
+//
+// Use FragmentHTML, FragmentTag or FragmentFunc to create a fragment from
+// trusted markup, an hb tag or a rendering function.
+type Fragment interface {
+	// Render writes the fragment to w, ctx is the context of the HTTP
+	// request set with SetHTTPRequest, or context.Background()
+	Render(ctx context.Context, w io.Writer) error
+}
+
+// Component is the name of Fragment for values rendering themselves, such
+// as templ components: any value with the Render(ctx, w) error method is
+// a component, without converting it or importing its package
+type Component = Fragment
+
+// FragmentFunc is an adapter to use an ordinary function as a fragment,
+// like http.HandlerFunc for handlers. The function receives the context of
+// the render, e.g. to execute an html/template with the data of the request
+type FragmentFunc func(ctx context.Context, w io.Writer) error
+
+// Render calls f(ctx, w)
+func (f FragmentFunc) Render(ctx context.Context, w io.Writer) error {
+	return f(ctx, w)
+}
+
+// FragmentHTML returns a fragment writing the trusted markup as is
+func FragmentHTML(html HTML) Fragment {
+	return FragmentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, string(html))
+		return err
+	})
+}
+
+// FragmentTag returns a fragment writing the hb tag
+func FragmentTag(tag hb.TagInterface) Fragment {
+	return FragmentFunc(func(ctx context.Context, w io.Writer) error {
+		if tag == nil {
+			return nil
+		}
+		_, err := io.WriteString(w, tag.ToHTML())
+		return err
+	})
+}
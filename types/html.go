@@ -0,0 +1,11 @@
+package types
+
+import "html/template"
+
+// HTML is markup trusted by the application, rendered without escaping.
+//
+// All other string fields, such as titles, user names and messages, are
+// escaped when rendered. Only use HTML for markup from a trusted source,
+// never for user input. It is the same type as template.HTML, so values
+// from html/template can be used as is.
+type HTML = template.HTML
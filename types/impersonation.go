@@ -0,0 +1,10 @@
+package types
+
+// Impersonation represents a real user, e.g. a member of the support staff,
+// acting as the user of the dashboard (the effective user, see SetUser)
+type Impersonation struct {
+	RealUser      User   // The user who is impersonating
+	StopURL       string // URL the "stop impersonating" form is posted to
+	CSRFFieldName string // Name of the hidden CSRF field (default: "csrf_token")
+	CSRFToken     string // Value of the hidden CSRF field, the field is omitted when empty
+}
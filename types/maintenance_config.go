@@ -0,0 +1,33 @@
+package types
+
+import (
+	"net/http"
+	"time"
+)
+
+// MaintenanceConfig configures the maintenance mode middleware
+type MaintenanceConfig struct {
+	// Enabled reports whether the maintenance mode is on, e.g. reading a flag
+	Enabled func() bool
+	// File turns the maintenance mode on while the file exists
+	File string
+
+	Message    string        // Message of the page, defaults to the translated maintenance message
+	ETA        time.Time     // Expected end of the maintenance, shown on the page and sent as Retry-After
+	RetryAfter time.Duration // Retry-After sent without an ETA (default: 5 minutes)
+
+	AllowedIPs   []string // IP addresses or CIDR ranges bypassing the maintenance mode, e.g. "10.0.0.0/8"
+	AllowedRoles []string // Roles of the users bypassing the maintenance mode
+	AllowedPaths []string // Path prefixes always served, e.g. the static assets and the theme handler
+
+	// ClientIP returns the IP address of the request (default: the
+	// RemoteAddr of the request, the proxy headers are not trusted). Behind
+	// a proxy, use e.g. req.GetIPWithOptions with the trusted proxies
+	ClientIP func(r *http.Request) string
+	// UserRoles returns the roles of the user of the request, checked
+	// against AllowedRoles
+	UserRoles func(r *http.Request) []string
+	// Dashboard returns the dashboard rendering the maintenance page, the
+	// page is standalone when nil
+	Dashboard func(r *http.Request) DashboardInterface
+}
@@ -1,10 +1,18 @@
 package types
 
+// MenuItem represents an entry of the main, user or quick access menu
 type MenuItem struct {
-	Title    string
-	URL      string
-	Target   string
-	Icon     string
+	Title string
+	URL   string
+	// Target of the URL, e.g. "_blank" (optional)
+	Target string
+	// Icon of the item (optional). What it holds depends on the template:
+	// Bootstrap and Tabler render it as an HTML snippet, e.g.
+	// `<i class="bi bi-house"></i>` or an inline SVG, while AdminLTE uses
+	// it as the CSS classes of an <i> element, e.g. "fas fa-home". The
+	// command palette accepts both. It is trusted markup, never set it from
+	// user input.
+	Icon     HTML
 	Sequence int
 	IsActive bool
 	Children []MenuItem
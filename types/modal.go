@@ -2,10 +2,12 @@ package types
 
 // Modal represents a Bootstrap/Tabler modal dialog
 type Modal struct {
-	ID          string // Unique ID for the modal
-	Title       string // Modal title
-	Content     string // Modal content (HTML)
-	Size        string // Modal size (sm, lg, xl, or empty for default)
-	Footer      string // Modal footer content (HTML)
-	CloseButton bool   // Whether to show the close button (default: true)
+	ID             string   // Unique ID for the modal
+	Title          string   // Modal title
+	Content        HTML     // Modal content (HTML)
+	Body           Fragment // Modal content written while rendering, takes precedence over Content
+	Size           string   // Modal size (sm, lg, xl, or empty for default)
+	Footer         HTML     // Modal footer content (HTML)
+	FooterFragment Fragment // Modal footer written while rendering, takes precedence over Footer
+	CloseButton    bool     // Whether to show the close button (default: true)
 }
@@ -0,0 +1,15 @@
+package types
+
+// SearchResult represents a single result of the global search
+type SearchResult struct {
+	Title    string `json:"title"`              // Display text of the result
+	URL      string `json:"url"`                // URL the result links to
+	Icon     string `json:"icon,omitempty"`     // Icon CSS classes, e.g. "bi bi-person" (optional)
+	Category string `json:"category,omitempty"` // Category the result is grouped under, e.g. "Users"
+}
+
+// SearchResultGroup represents the search results of a single category
+type SearchResultGroup struct {
+	Category string         `json:"category"`
+	Results  []SearchResult `json:"results"`
+}
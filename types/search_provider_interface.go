@@ -0,0 +1,19 @@
+package types
+
+import "context"
+
+// SearchProviderInterface is implemented by the application to answer the
+// queries typed in the global search box of the navbar
+type SearchProviderInterface interface {
+	// Search returns the results for the query, the results are grouped by
+	// their category in the order in which the categories first appear
+	Search(ctx context.Context, query string) ([]SearchResult, error)
+}
+
+// SearchProviderFunc is an adapter to use an ordinary function as a search provider
+type SearchProviderFunc func(ctx context.Context, query string) ([]SearchResult, error)
+
+// Search calls f(ctx, query)
+func (f SearchProviderFunc) Search(ctx context.Context, query string) ([]SearchResult, error) {
+	return f(ctx, query)
+}
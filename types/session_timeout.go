@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// SessionTimeout configures the session timeout countdown, a modal shown
+// before the session expires, offering to stay signed in
+type SessionTimeout struct {
+	ExpiresAt     time.Time     // When the session expires, the countdown is omitted when zero
+	WarnBefore    time.Duration // How long before the expiry the modal is shown (default: 2 minutes)
+	KeepAliveURL  string        // URL the "stay signed in" request is posted to, the button is omitted when empty
+	CSRFFieldName string        // Name of the CSRF field of the request (default: "csrf_token")
+	CSRFToken     string        // Value of the CSRF field, the field is omitted when empty
+}
@@ -1,5 +1,12 @@
 package types
 
+// TemplateInterface renders the page of the dashboard.
+//
+// ToHTML returns the page with placeholders where the fragments go (the
+// content, modal bodies and footers, footer, layout regions and widgets),
+// not the complete page. The fragments are streamed in place of the
+// placeholders by the Render method of the dashboard, use the Render or
+// ToHTML methods of the dashboard for the complete page.
 type TemplateInterface interface {
 	ToHTML(dashboard DashboardInterface) string
 }
@@ -0,0 +1,11 @@
+package types
+
+// Theme describes a theme offered by a template
+type Theme struct {
+	ID               string   // Theme identifier, as stored in the theme cookie
+	Name             string   // Display name
+	IsDark           bool     // Whether this is a dark theme
+	PreviewColors    []string // Preview colors: primary color first, then background color
+	StylesheetURL    string   // Stylesheet URL for the theme
+	StylesheetURLRTL string   // Right-to-left build of the stylesheet, empty if the theme has none
+}
@@ -0,0 +1,9 @@
+package types
+
+// ThemeCatalogInterface is implemented by templates which offer a list of themes
+type ThemeCatalogInterface interface {
+	// Themes returns the themes offered by the template, in display order
+	Themes() []Theme
+	// ThemeDefault returns the ID of the default theme of the template
+	ThemeDefault() string
+}
@@ -0,0 +1,9 @@
+package types
+
+// TranslatorInterface translates the built-in UI strings of the dashboard,
+// the message keys are the shared.MESSAGE_* constants
+type TranslatorInterface interface {
+	// Translate returns the message for the key in the locale, or an empty
+	// string when there is no translation (the English default is used then)
+	Translate(locale, key string) string
+}
@@ -1,8 +1,103 @@
 package types
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// User represents the signed in user, shown in the user menus
 type User struct {
-	AvatarURL string
-	Email     string
-	FirstName string
-	LastName  string
+	ID          string            // Unique ID of the user in the application
+	DisplayName string            // Name shown in the user menus, defaults to the first and last names
+	FirstName   string            // First name
+	LastName    string            // Last name
+	Email       string            // Email address, also used for the Gravatar
+	JobTitle    string            // Job title, shown below the name
+	AvatarURL   string            // URL of the avatar image
+	Gravatar    bool              // Whether to show the Gravatar of the email when there is no avatar URL
+	Roles       []string          // Roles of the user, e.g. "admin"
+	Status      string            // Online status, see the shared.USER_STATUS_* constants, no status dot when empty
+	Attributes  map[string]string // Attributes of the application, e.g. the department
+}
+
+// Name returns the name shown in the user menus: the display name, the
+// first and last names, or the email
+func (u User) Name() string {
+	if name := strings.TrimSpace(u.DisplayName); name != "" {
+		return name
+	}
+
+	if name := strings.TrimSpace(u.FirstName + " " + u.LastName); name != "" {
+		return name
+	}
+
+	return u.Email
+}
+
+// Initials returns the upper case initials of the user, at most two, from
+// the first and last names or from the first and last words of the name.
+// Initials are whole runes, so names in any script are safe.
+func (u User) Initials() string {
+	first, last := strings.TrimSpace(u.FirstName), strings.TrimSpace(u.LastName)
+
+	if first == "" && last == "" {
+		words := strings.Fields(u.Name())
+		if len(words) == 0 {
+			return ""
+		}
+		first = words[0]
+		if len(words) > 1 {
+			last = words[len(words)-1]
+		}
+	}
+
+	return initial(first) + initial(last)
+}
+
+// HasRole returns whether the user has the role
+func (u User) HasRole(role string) bool {
+	for _, userRole := range u.Roles {
+		if userRole == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Attribute returns the value of the attribute, or an empty string
+func (u User) Attribute(key string) string {
+	return u.Attributes[key]
+}
+
+// AvatarImageURL returns the URL of the avatar image: the avatar URL, or
+// the Gravatar of the email when enabled, or an empty string when the
+// initials are shown instead
+//
+// Parameters:
+//   - size: the size of the Gravatar, in pixels
+func (u User) AvatarImageURL(size int) string {
+	if u.AvatarURL != "" {
+		return u.AvatarURL
+	}
+
+	email := strings.ToLower(strings.TrimSpace(u.Email))
+	if !u.Gravatar || email == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(email))
+	return "https://www.gravatar.com/avatar/" + hex.EncodeToString(hash[:]) + "?s=" + strconv.Itoa(size) + "&d=mp"
+}
+
+// initial returns the first letter or digit of the word, in upper case
+func initial(word string) string {
+	for _, r := range word {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return string(unicode.ToUpper(r))
+		}
+	}
+	return ""
 }
@@ -0,0 +1,9 @@
+package types
+
+// Widget represents a card of the widget grid shown above the content
+type Widget struct {
+	ID      string    // Optional ID of the card
+	Title   string    // Card title, the card header is omitted when empty
+	Body    Component // Card body, written while rendering
+	Columns int       // Width in grid columns from medium screens up, 1 to 12 (default: 12)
+}
@@ -0,0 +1,131 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dracory/dashboard"
+	"github.com/dracory/dashboard/shared"
+	"github.com/dracory/dashboard/types"
+)
+
+func TestUserNameAndInitials(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     types.User
+		expected string
+		initials string
+	}{
+		{name: "first and last name", user: types.User{FirstName: "jane", LastName: "doe"}, expected: "jane doe", initials: "JD"},
+		{name: "display name", user: types.User{DisplayName: "Dr. Jane Doe", FirstName: "Jane"}, expected: "Dr. Jane Doe", initials: "J"},
+		{name: "display name only", user: types.User{DisplayName: "Jane van der Berg"}, expected: "Jane van der Berg", initials: "JB"},
+		{name: "multi-byte", user: types.User{FirstName: "Ørjan", LastName: "Ødegård"}, expected: "Ørjan Ødegård", initials: "ØØ"},
+		{name: "lower case multi-byte", user: types.User{FirstName: "élodie", LastName: "ßmith"}, expected: "élodie ßmith", initials: "Éß"},
+		{name: "cyrillic", user: types.User{FirstName: "Юлия", LastName: "Смирнова"}, expected: "Юлия Смирнова", initials: "ЮС"},
+		{name: "chinese", user: types.User{FirstName: "小龙", LastName: "李"}, expected: "小龙 李", initials: "小李"},
+		{name: "punctuation", user: types.User{FirstName: "'Jane", LastName: "(Doe)"}, expected: "'Jane (Doe)", initials: "JD"},
+		{name: "email only", user: types.User{Email: "jane@example.com"}, expected: "jane@example.com", initials: "J"},
+		{name: "empty", user: types.User{}, expected: "", initials: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if name := tt.user.Name(); name != tt.expected {
+				t.Errorf("expected the name %q, got %q", tt.expected, name)
+			}
+			if initials := tt.user.Initials(); initials != tt.initials {
+				t.Errorf("expected the initials %q, got %q", tt.initials, initials)
+			}
+		})
+	}
+}
+
+func TestUserAvatarImageURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     types.User
+		expected string
+	}{
+		{name: "avatar", user: types.User{AvatarURL: "/jane.png", Gravatar: true, Email: "jane@example.com"}, expected: "/jane.png"},
+		{name: "gravatar", user: types.User{Gravatar: true, Email: " Jane@Example.com "}, expected: "https://www.gravatar.com/avatar/8c87b489ce35cf2e2f39f80e282cb2e804932a56a213983eeeb428407d43b52d?s=64&d=mp"},
+		{name: "gravatar without email", user: types.User{Gravatar: true}, expected: ""},
+		{name: "initials", user: types.User{Email: "jane@example.com"}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if url := tt.user.AvatarImageURL(64); url != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, url)
+			}
+		})
+	}
+}
+
+func TestUserRolesAndAttributes(t *testing.T) {
+	user := types.User{Roles: []string{"editor", "admin"}, Attributes: map[string]string{"department": "Sales"}}
+
+	if !user.HasRole("admin") || user.HasRole("owner") {
+		t.Error("expected the user to have the admin role only")
+	}
+
+	if user.Attribute("department") != "Sales" || user.Attribute("missing") != "" {
+		t.Error("unexpected attribute values")
+	}
+}
+
+func TestUserAvatarRendering(t *testing.T) {
+	templates := []string{shared.TEMPLATE_BOOTSTRAP, shared.TEMPLATE_TABLER, shared.TEMPLATE_ADMINLTE}
+
+	tests := []struct {
+		name        string
+		user        types.User
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name: "initials and status",
+			user: types.User{FirstName: "Ørjan", LastName: "Ødegård", JobTitle: "Designer", Status: shared.USER_STATUS_ONLINE},
+			expected: []string{
+				`class="dashboard-avatar-image dashboard-avatar-initials" src="data:image/svg+xml;base64,`,
+				`>Ørjan Ødegård<`,
+				`Designer`,
+				`aria-label="Online" class="dashboard-avatar-status dashboard-avatar-status-online" role="img"`,
+			},
+			notExpected: []string{"\xc3<", "gravatar.com"},
+		},
+		{
+			name:        "avatar image",
+			user:        types.User{DisplayName: "Jane", AvatarURL: "/jane.png"},
+			expected:    []string{`class="dashboard-avatar-image" src="/jane.png"`},
+			notExpected: []string{"dashboard-avatar-initials", "dashboard-avatar-status"},
+		},
+		{
+			name:     "gravatar",
+			user:     types.User{FirstName: "Jane", Email: "jane@example.com", Gravatar: true, Status: shared.USER_STATUS_BUSY},
+			expected: []string{`src="https://www.gravatar.com/avatar/8c87b489ce35cf2e2f39f80e282cb2e804932a56a213983eeeb428407d43b52d?s=`, `aria-label="Busy"`},
+		},
+	}
+
+	for _, template := range templates {
+		for _, tt := range tests {
+			t.Run(template+" "+tt.name, func(t *testing.T) {
+				d := dashboard.New()
+				d.SetTemplate(template)
+				d.SetUser(tt.user)
+
+				html := d.ToHTML()
+
+				for _, s := range tt.expected {
+					if !strings.Contains(html, s) {
+						t.Errorf("expected HTML to contain %q", s)
+					}
+				}
+				for _, s := range tt.notExpected {
+					if strings.Contains(html, s) {
+						t.Errorf("expected HTML not to contain %q", s)
+					}
+				}
+			})
+		}
+	}
+}